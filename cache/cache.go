@@ -0,0 +1,288 @@
+package cache
+
+import (
+	"sync"
+	"time"
+)
+
+// Reason tells an eviction listener why an entry left the cache
+type Reason int
+
+const (
+	// Evicted entries were dropped by the policy to make room
+	Evicted Reason = iota
+	// Expired entries outlived their TTL
+	Expired
+	// Removed entries were deleted explicitly or by Clear
+	Removed
+	// Replaced entries were overwritten by a Put for the same key
+	Replaced
+)
+
+func (r Reason) String() string {
+	switch r {
+	case Evicted:
+		return "evicted"
+	case Expired:
+		return "expired"
+	case Removed:
+		return "removed"
+	case Replaced:
+		return "replaced"
+	}
+	return "Reason(?)"
+}
+
+// Config describes a cache. The zero value is an unbounded LRU cache whose
+// entries never expire.
+type Config[K comparable, V any] struct {
+	// Capacity bounds the number of entries; zero or less means unbounded
+	Capacity int
+	// Policy picks the entry to evict once Capacity is reached
+	Policy Policy
+	// TTL is the default lifetime of an entry; zero means no expiry
+	TTL time.Duration
+	// CleanupInterval, when positive, starts a goroutine that drops expired
+	// entries periodically. Otherwise they're dropped when next touched.
+	CleanupInterval time.Duration
+	// OnEvict is called after an entry leaves the cache, outside the
+	// cache's lock
+	OnEvict func(key K, value V, reason Reason)
+	// Loader computes missing values for Load
+	Loader func(key K) (V, error)
+}
+
+type entry[V any] struct {
+	value   V
+	expires time.Time
+}
+
+func (e *entry[V]) expired(now time.Time) bool {
+	return !e.expires.IsZero() && !now.Before(e.expires)
+}
+
+// notice is an eviction waiting to be reported once the lock is released
+type notice[K comparable, V any] struct {
+	key    K
+	value  V
+	reason Reason
+}
+
+// Cache is a bounded key/value cache with pluggable eviction, expiry and
+// loading. It is safe for concurrent use.
+type Cache[K comparable, V any] struct {
+	mu      sync.Mutex
+	config  Config[K, V]
+	entries map[K]*entry[V]
+	tracker tracker[K]
+	calls   map[K]*call[V]
+	stats   Stats
+
+	stop      chan struct{}
+	closeOnce sync.Once
+}
+
+// New creates a cache described by config. If config.CleanupInterval is
+// positive, Close must be called to stop the cleanup goroutine.
+func New[K comparable, V any](config Config[K, V]) *Cache[K, V] {
+	c := &Cache[K, V]{
+		config:  config,
+		entries: make(map[K]*entry[V]),
+		tracker: newTracker[K](config.Policy),
+		calls:   make(map[K]*call[V]),
+		stop:    make(chan struct{}),
+	}
+	if config.CleanupInterval > 0 {
+		go c.janitor(config.CleanupInterval)
+	}
+	return c
+}
+
+func (c *Cache[K, V]) janitor(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			c.EvictExpired()
+		case <-c.stop:
+			return
+		}
+	}
+}
+
+// Close stops the background cleanup, if any. The cache remains usable
+// afterwards, with lazy expiry only.
+func (c *Cache[K, V]) Close() {
+	c.closeOnce.Do(func() {
+		close(c.stop)
+	})
+}
+
+// Get returns the value cached for key, counting a hit or a miss
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	var notices []notice[K, V]
+	value, ok := c.lookup(key, &notices)
+	c.mu.Unlock()
+
+	c.notify(notices)
+	return value, ok
+}
+
+// lookup finds a live entry, dropping it if it has expired, and records
+// the access
+func (c *Cache[K, V]) lookup(key K, notices *[]notice[K, V]) (V, bool) {
+	e, ok := c.entries[key]
+	if ok && e.expired(time.Now()) {
+		c.remove(key, Expired, notices)
+		ok = false
+	}
+
+	if !ok {
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+
+	c.stats.Hits++
+	c.tracker.accessed(key)
+	return e.value, true
+}
+
+// Contains reports whether key has a live entry, without counting as an
+// access
+func (c *Cache[K, V]) Contains(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	return ok && !e.expired(time.Now())
+}
+
+// Put caches value under key with the default TTL
+func (c *Cache[K, V]) Put(key K, value V) {
+	c.PutWithTTL(key, value, c.config.TTL)
+}
+
+// PutWithTTL caches value under key for ttl; zero or less means the entry
+// never expires
+func (c *Cache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	c.mu.Lock()
+	var notices []notice[K, V]
+	// A load already running for key would overwrite this newer value
+	delete(c.calls, key)
+	c.put(key, value, ttl, &notices)
+	c.mu.Unlock()
+
+	c.notify(notices)
+}
+
+func (c *Cache[K, V]) put(key K, value V, ttl time.Duration, notices *[]notice[K, V]) {
+	var expires time.Time
+	if ttl > 0 {
+		expires = time.Now().Add(ttl)
+	}
+
+	if e, ok := c.entries[key]; ok {
+		*notices = append(*notices, notice[K, V]{key, e.value, Replaced})
+		e.value, e.expires = value, expires
+		c.tracker.accessed(key)
+		return
+	}
+
+	// Make room first, so the new entry can't be picked as its own victim
+	for c.config.Capacity > 0 && len(c.entries) >= c.config.Capacity {
+		victim, _ := c.tracker.victim()
+		c.remove(victim, Evicted, notices)
+	}
+
+	c.entries[key] = &entry[V]{value: value, expires: expires}
+	c.tracker.added(key)
+}
+
+// Delete drops the entry for key, reporting whether there was one
+func (c *Cache[K, V]) Delete(key K) bool {
+	c.mu.Lock()
+	var notices []notice[K, V]
+	delete(c.calls, key)
+	_, ok := c.entries[key]
+	if ok {
+		c.remove(key, Removed, &notices)
+	}
+	c.mu.Unlock()
+
+	c.notify(notices)
+	return ok
+}
+
+func (c *Cache[K, V]) remove(key K, reason Reason, notices *[]notice[K, V]) {
+	e := c.entries[key]
+	delete(c.entries, key)
+	c.tracker.removed(key)
+
+	switch reason {
+	case Evicted:
+		c.stats.Evictions++
+	case Expired:
+		c.stats.Expirations++
+	}
+	*notices = append(*notices, notice[K, V]{key, e.value, reason})
+}
+
+// EvictExpired drops every expired entry and returns how many there were
+func (c *Cache[K, V]) EvictExpired() int {
+	c.mu.Lock()
+	var notices []notice[K, V]
+	now := time.Now()
+	for key, e := range c.entries {
+		if e.expired(now) {
+			c.remove(key, Expired, &notices)
+		}
+	}
+	c.mu.Unlock()
+
+	c.notify(notices)
+	return len(notices)
+}
+
+// Clear drops every entry, reporting each as Removed
+func (c *Cache[K, V]) Clear() {
+	c.mu.Lock()
+	var notices []notice[K, V]
+	if c.config.OnEvict != nil {
+		notices = make([]notice[K, V], 0, len(c.entries))
+		for key, e := range c.entries {
+			notices = append(notices, notice[K, V]{key, e.value, Removed})
+		}
+	}
+	clear(c.entries)
+	clear(c.calls)
+	c.tracker.clear()
+	c.mu.Unlock()
+
+	c.notify(notices)
+}
+
+// Len returns the number of entries, including expired ones that haven't
+// been dropped yet
+func (c *Cache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+// Stats returns a snapshot of the cache's counters
+func (c *Cache[K, V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
+
+func (c *Cache[K, V]) notify(notices []notice[K, V]) {
+	if c.config.OnEvict == nil {
+		return
+	}
+	for _, n := range notices {
+		c.config.OnEvict(n.key, n.value, n.reason)
+	}
+}
@@ -0,0 +1,262 @@
+package cache_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/avila-r/ego/cache"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Cache_Policies(t *testing.T) {
+	type Case struct {
+		name     string
+		policy   cache.Policy
+		evicted  []string
+		retained []string
+	}
+
+	// Every case inserts a, b and c, reads a twice and b once, then adds d
+	cases := []Case{
+		{"LRU evicts least recently read", cache.LRU, []string{"c"}, []string{"a", "b", "d"}},
+		{"LFU evicts least frequently read", cache.LFU, []string{"c"}, []string{"a", "b", "d"}},
+		{"FIFO evicts oldest", cache.FIFO, []string{"a"}, []string{"b", "c", "d"}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var evicted []string
+			cached := cache.New(cache.Config[string, int]{
+				Capacity: 3,
+				Policy:   c.policy,
+				OnEvict: func(key string, _ int, reason cache.Reason) {
+					assert.Equal(t, cache.Evicted, reason)
+					evicted = append(evicted, key)
+				},
+			})
+
+			cached.Put("a", 1)
+			cached.Put("b", 2)
+			cached.Put("c", 3)
+			cached.Get("a")
+			cached.Get("b")
+			cached.Get("a")
+			cached.Put("d", 4)
+
+			assert.Equal(t, c.evicted, evicted)
+			assert.Equal(t, 3, cached.Len())
+			for _, key := range c.retained {
+				assert.True(t, cached.Contains(key), key)
+			}
+		})
+	}
+}
+
+func Test_Cache_LRU_PutCountsAsUse(t *testing.T) {
+	cached := cache.New(cache.Config[string, int]{Capacity: 2})
+
+	cached.Put("a", 1)
+	cached.Put("b", 2)
+	cached.Put("a", 10)
+	cached.Put("c", 3)
+
+	assert.False(t, cached.Contains("b"))
+	value, _ := cached.Get("a")
+	assert.Equal(t, 10, value)
+}
+
+func Test_Cache_LFU_TiesByRecency(t *testing.T) {
+	cached := cache.New(cache.Config[int, int]{Capacity: 3, Policy: cache.LFU})
+
+	for i := range 3 {
+		cached.Put(i, i)
+	}
+	cached.Get(0)
+	cached.Get(1)
+	cached.Get(2)
+	cached.Delete(1)
+	cached.Put(3, 3)
+	cached.Put(4, 4)
+
+	// 3 is the only key read once or less when 4 arrives
+	assert.False(t, cached.Contains(3))
+	assert.True(t, cached.Contains(0))
+	assert.True(t, cached.Contains(2))
+	assert.True(t, cached.Contains(4))
+}
+
+func Test_Cache_LFU_NewKeyIsKept(t *testing.T) {
+	var evicted []int
+	cached := cache.New(cache.Config[int, int]{
+		Capacity: 3,
+		Policy:   cache.LFU,
+		OnEvict: func(key int, _ int, _ cache.Reason) {
+			evicted = append(evicted, key)
+		},
+	})
+
+	for i := range 3 {
+		cached.Put(i, i)
+	}
+	for range 2 {
+		for i := range 3 {
+			cached.Get(i)
+		}
+	}
+	cached.Put(3, 3)
+
+	assert.True(t, cached.Contains(3))
+	assert.Equal(t, []int{0}, evicted)
+	assert.Equal(t, 3, cached.Len())
+}
+
+func Test_Cache_TTL(t *testing.T) {
+	var expired []string
+	cached := cache.New(cache.Config[string, int]{
+		TTL: 20 * time.Millisecond,
+		OnEvict: func(key string, _ int, reason cache.Reason) {
+			if reason == cache.Expired {
+				expired = append(expired, key)
+			}
+		},
+	})
+
+	cached.Put("short", 1)
+	cached.PutWithTTL("long", 2, time.Hour)
+	cached.PutWithTTL("forever", 3, 0)
+
+	time.Sleep(30 * time.Millisecond)
+
+	_, ok := cached.Get("short")
+	assert.False(t, ok)
+	assert.True(t, cached.Contains("long"))
+	assert.True(t, cached.Contains("forever"))
+	assert.Equal(t, []string{"short"}, expired)
+	assert.Equal(t, uint64(1), cached.Stats().Expirations)
+}
+
+func Test_Cache_BackgroundExpiry(t *testing.T) {
+	cached := cache.New(cache.Config[int, int]{
+		TTL:             10 * time.Millisecond,
+		CleanupInterval: 5 * time.Millisecond,
+	})
+	defer cached.Close()
+
+	for i := range 10 {
+		cached.Put(i, i)
+	}
+
+	assert.Eventually(t, func() bool {
+		return cached.Len() == 0
+	}, time.Second, 5*time.Millisecond)
+	cached.Close()
+}
+
+func Test_Cache_EvictExpired(t *testing.T) {
+	cached := cache.New(cache.Config[int, int]{})
+	cached.PutWithTTL(1, 1, time.Millisecond)
+	cached.PutWithTTL(2, 2, time.Millisecond)
+	cached.Put(3, 3)
+
+	time.Sleep(5 * time.Millisecond)
+
+	assert.Equal(t, 3, cached.Len())
+	assert.Equal(t, 2, cached.EvictExpired())
+	assert.Equal(t, 1, cached.Len())
+}
+
+func Test_Cache_Listener(t *testing.T) {
+	type Notice struct {
+		key    string
+		value  int
+		reason cache.Reason
+	}
+
+	var notices []Notice
+	cached := cache.New(cache.Config[string, int]{
+		OnEvict: func(key string, value int, reason cache.Reason) {
+			notices = append(notices, Notice{key, value, reason})
+		},
+	})
+
+	cached.Put("a", 1)
+	cached.Put("a", 2)
+	cached.Delete("a")
+	assert.False(t, cached.Delete("a"))
+	cached.Put("b", 3)
+	cached.Clear()
+
+	assert.Equal(t, []Notice{
+		{"a", 1, cache.Replaced},
+		{"a", 2, cache.Removed},
+		{"b", 3, cache.Removed},
+	}, notices)
+	assert.Zero(t, cached.Len())
+}
+
+func Test_Cache_ListenerMayUseCache(t *testing.T) {
+	var cached *cache.Cache[int, int]
+	cached = cache.New(cache.Config[int, int]{
+		Capacity: 1,
+		OnEvict: func(key, value int, _ cache.Reason) {
+			cached.Contains(key)
+		},
+	})
+
+	cached.Put(1, 1)
+	cached.Put(2, 2)
+	assert.True(t, cached.Contains(2))
+}
+
+func Test_Cache_Stats(t *testing.T) {
+	cached := cache.New(cache.Config[string, int]{Capacity: 1})
+
+	cached.Put("a", 1)
+	cached.Get("a")
+	cached.Get("a")
+	cached.Get("b")
+	cached.Put("b", 2)
+
+	stats := cached.Stats()
+	assert.Equal(t, uint64(2), stats.Hits)
+	assert.Equal(t, uint64(1), stats.Misses)
+	assert.Equal(t, uint64(1), stats.Evictions)
+	assert.Equal(t, uint64(3), stats.Requests())
+	assert.InDelta(t, 2.0/3.0, stats.HitRate(), 1e-9)
+	assert.Zero(t, cache.Stats{}.HitRate())
+}
+
+func Test_Cache_Concurrent(t *testing.T) {
+	cached := cache.New(cache.Config[int, int]{
+		Capacity:        64,
+		Policy:          cache.LFU,
+		TTL:             time.Millisecond,
+		CleanupInterval: time.Millisecond,
+	})
+	defer cached.Close()
+
+	var wg sync.WaitGroup
+	for g := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 2000 {
+				key := (g*31 + i) % 100
+				switch i % 4 {
+				case 0:
+					cached.Put(key, i)
+				case 1:
+					cached.Get(key)
+				case 2:
+					cached.GetOrLoad(key, func(key int) (int, error) { return key, nil })
+				default:
+					cached.Delete(key)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, cached.Len(), 64)
+}
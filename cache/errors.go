@@ -0,0 +1,12 @@
+package cache
+
+import (
+	"github.com/avila-r/ego"
+)
+
+var errors = ego.ExtendedGoErrorsNamespace.Class("cache")
+
+var (
+	ErrNoLoader    = errors.New("cache has no loader")
+	ErrLoaderPanic = errors.New("loader panicked")
+)
@@ -0,0 +1,82 @@
+package cache
+
+// call is a load in progress; concurrent callers for the same key wait on
+// done and share its outcome
+type call[V any] struct {
+	done  chan struct{}
+	value V
+	err   error
+}
+
+// Load returns the value cached for key, computing it with the configured
+// loader on a miss. It fails with ErrNoLoader if the cache has none.
+func (c *Cache[K, V]) Load(key K) (V, error) {
+	if c.config.Loader == nil {
+		var zero V
+		return zero, ErrNoLoader
+	}
+	return c.GetOrLoad(key, c.config.Loader)
+}
+
+// GetOrLoad returns the value cached for key, computing it with loader on
+// a miss. Concurrent misses for the same key share a single call to
+// loader. Errors are returned to every waiting caller and not cached.
+func (c *Cache[K, V]) GetOrLoad(key K, loader func(key K) (V, error)) (V, error) {
+	c.mu.Lock()
+	var notices []notice[K, V]
+	if value, ok := c.lookup(key, &notices); ok {
+		c.mu.Unlock()
+		c.notify(notices)
+		return value, nil
+	}
+
+	pending, running := c.calls[key]
+	if !running {
+		pending = &call[V]{done: make(chan struct{})}
+		c.calls[key] = pending
+	}
+	c.mu.Unlock()
+	c.notify(notices)
+
+	if running {
+		<-pending.done
+	} else {
+		c.load(key, pending, loader)
+	}
+	return pending.value, pending.err
+}
+
+func (c *Cache[K, V]) load(key K, pending *call[V], loader func(key K) (V, error)) {
+	panicked := true
+	defer func() {
+		if panicked {
+			pending.err = ErrLoaderPanic
+		}
+		c.finish(key, pending)
+	}()
+
+	pending.value, pending.err = loader(key)
+	panicked = false
+}
+
+// finish stores a load's result, unless a Put, Delete or Clear for the same
+// key made it stale, and releases the waiting callers
+func (c *Cache[K, V]) finish(key K, pending *call[V]) {
+	c.mu.Lock()
+	var notices []notice[K, V]
+	if pending.err != nil {
+		c.stats.LoadErrors++
+	} else {
+		c.stats.Loads++
+	}
+	if c.calls[key] == pending {
+		delete(c.calls, key)
+		if pending.err == nil {
+			c.put(key, pending.value, c.config.TTL, &notices)
+		}
+	}
+	c.mu.Unlock()
+
+	close(pending.done)
+	c.notify(notices)
+}
@@ -0,0 +1,144 @@
+package cache_test
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/avila-r/ego/cache"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Cache_Load(t *testing.T) {
+	calls := 0
+	cached := cache.New(cache.Config[int, string]{
+		Loader: func(key int) (string, error) {
+			calls++
+			return string(rune('a' + key)), nil
+		},
+	})
+
+	value, err := cached.Load(1)
+	assert.NoError(t, err)
+	assert.Equal(t, "b", value)
+
+	value, _ = cached.Load(1)
+	assert.Equal(t, "b", value)
+	assert.Equal(t, 1, calls)
+
+	stats := cached.Stats()
+	assert.Equal(t, uint64(1), stats.Loads)
+	assert.Equal(t, uint64(1), stats.Hits)
+	assert.Equal(t, uint64(1), stats.Misses)
+}
+
+func Test_Cache_Load_NoLoader(t *testing.T) {
+	_, err := cache.New(cache.Config[int, int]{}).Load(1)
+
+	assert.ErrorIs(t, err, cache.ErrNoLoader)
+}
+
+func Test_Cache_GetOrLoad_ErrorsAreNotCached(t *testing.T) {
+	cached := cache.New(cache.Config[string, int]{})
+	broken := errors.New("unavailable")
+
+	_, err := cached.GetOrLoad("a", func(string) (int, error) { return 0, broken })
+	assert.ErrorIs(t, err, broken)
+	assert.False(t, cached.Contains("a"))
+
+	value, err := cached.GetOrLoad("a", func(string) (int, error) { return 1, nil })
+	assert.NoError(t, err)
+	assert.Equal(t, 1, value)
+	assert.Equal(t, uint64(1), cached.Stats().LoadErrors)
+}
+
+func Test_Cache_GetOrLoad_SingleFlight(t *testing.T) {
+	cached := cache.New(cache.Config[string, int]{})
+	release := make(chan struct{})
+	var calls atomic.Int32
+
+	loader := func(string) (int, error) {
+		calls.Add(1)
+		<-release
+		return 42, nil
+	}
+
+	const callers = 16
+	var wg sync.WaitGroup
+	results := make([]int, callers)
+	for i := range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], _ = cached.GetOrLoad("answer", loader)
+		}()
+	}
+
+	// Every caller has missed, and so joined the load, before it completes
+	assert.Eventually(t, func() bool {
+		return cached.Stats().Misses == callers
+	}, time.Second, time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), calls.Load())
+	assert.Equal(t, uint64(1), cached.Stats().Loads)
+	for _, result := range results {
+		assert.Equal(t, 42, result)
+	}
+}
+
+func Test_Cache_GetOrLoad_PutWins(t *testing.T) {
+	cached := cache.New(cache.Config[string, int]{})
+	loading := make(chan struct{})
+	release := make(chan struct{})
+
+	go func() {
+		<-loading
+		cached.Put("k", 2)
+		close(release)
+	}()
+
+	value, err := cached.GetOrLoad("k", func(string) (int, error) {
+		close(loading)
+		<-release
+		return 1, nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, value)
+	stored, _ := cached.Get("k")
+	assert.Equal(t, 2, stored)
+}
+
+func Test_Cache_GetOrLoad_Panic(t *testing.T) {
+	cached := cache.New(cache.Config[string, int]{})
+	release := make(chan struct{})
+
+	go func() {
+		defer func() { recover() }()
+		cached.GetOrLoad("k", func(string) (int, error) {
+			<-release
+			panic("boom")
+		})
+	}()
+	assert.Eventually(t, func() bool {
+		return cached.Stats().Misses == 1
+	}, time.Second, time.Millisecond)
+
+	waiting := make(chan error)
+	go func() {
+		_, err := cached.GetOrLoad("k", func(string) (int, error) { return 1, nil })
+		waiting <- err
+	}()
+	assert.Eventually(t, func() bool {
+		return cached.Stats().Misses == 2
+	}, time.Second, time.Millisecond)
+	close(release)
+
+	assert.ErrorIs(t, <-waiting, cache.ErrLoaderPanic)
+	assert.False(t, cached.Contains("k"))
+	assert.Equal(t, uint64(1), cached.Stats().LoadErrors)
+}
@@ -0,0 +1,168 @@
+package cache
+
+import (
+	"github.com/avila-r/ego/maps"
+)
+
+// Policy decides which entry a full cache evicts
+type Policy int
+
+const (
+	// LRU evicts the least recently used entry
+	LRU Policy = iota
+	// LFU evicts the least frequently used entry, breaking ties by recency
+	LFU
+	// FIFO evicts the oldest entry, regardless of how it's been used
+	FIFO
+)
+
+func (p Policy) String() string {
+	switch p {
+	case LRU:
+		return "LRU"
+	case LFU:
+		return "LFU"
+	case FIFO:
+		return "FIFO"
+	}
+	return "Policy(?)"
+}
+
+// tracker keeps the eviction order of the keys in a cache
+type tracker[K comparable] interface {
+	added(key K)
+	accessed(key K)
+	removed(key K)
+	victim() (K, bool)
+	clear()
+}
+
+func newTracker[K comparable](policy Policy) tracker[K] {
+	switch policy {
+	case LFU:
+		return newFrequencyTracker[K]()
+	case FIFO:
+		return &orderTracker[K]{order: maps.NewLinkedHashMap[K, struct{}]()}
+	default:
+		return &orderTracker[K]{order: maps.NewLinkedHashMap[K, struct{}](), reorder: true}
+	}
+}
+
+// orderTracker evicts the first key of a linked map. Moving keys to the back
+// on access yields LRU; leaving them in insertion order yields FIFO.
+type orderTracker[K comparable] struct {
+	order   *maps.LinkedHashMap[K, struct{}]
+	reorder bool
+}
+
+func (t *orderTracker[K]) added(key K) {
+	t.order.Put(key, struct{}{})
+}
+
+func (t *orderTracker[K]) accessed(key K) {
+	if t.reorder {
+		t.order.MoveToBack(key)
+	}
+}
+
+func (t *orderTracker[K]) removed(key K) {
+	t.order.Delete(key)
+}
+
+func (t *orderTracker[K]) victim() (K, bool) {
+	first, ok := t.order.First()
+	return first.Key, ok
+}
+
+func (t *orderTracker[K]) clear() {
+	t.order.Clear()
+}
+
+// frequencyTracker groups keys into one linked map per use count. Within a
+// count, keys are kept in LRU order. added, accessed and removed are O(1), and
+// so is victim unless a removal emptied the least used bucket. Then victim
+// scans the remaining buckets once to find the new minimum.
+type frequencyTracker[K comparable] struct {
+	counts  map[K]int
+	buckets map[int]*maps.LinkedHashMap[K, struct{}]
+	min     int
+}
+
+func newFrequencyTracker[K comparable]() *frequencyTracker[K] {
+	return &frequencyTracker[K]{
+		counts:  make(map[K]int),
+		buckets: make(map[int]*maps.LinkedHashMap[K, struct{}]),
+	}
+}
+
+func (t *frequencyTracker[K]) bucket(count int) *maps.LinkedHashMap[K, struct{}] {
+	bucket, ok := t.buckets[count]
+	if !ok {
+		bucket = maps.NewLinkedHashMap[K, struct{}]()
+		t.buckets[count] = bucket
+	}
+	return bucket
+}
+
+// unbucket takes key out of the bucket for count, dropping emptied buckets
+func (t *frequencyTracker[K]) unbucket(key K, count int) {
+	bucket := t.buckets[count]
+	bucket.Delete(key)
+	if bucket.IsEmpty() {
+		delete(t.buckets, count)
+	}
+}
+
+func (t *frequencyTracker[K]) added(key K) {
+	t.counts[key] = 1
+	t.bucket(1).Put(key, struct{}{})
+	t.min = 1
+}
+
+func (t *frequencyTracker[K]) accessed(key K) {
+	count, ok := t.counts[key]
+	if !ok {
+		return
+	}
+	t.unbucket(key, count)
+	if t.min == count && t.buckets[count] == nil {
+		t.min++
+	}
+	t.counts[key] = count + 1
+	t.bucket(count+1).Put(key, struct{}{})
+}
+
+func (t *frequencyTracker[K]) removed(key K) {
+	count, ok := t.counts[key]
+	if !ok {
+		return
+	}
+	delete(t.counts, key)
+	t.unbucket(key, count)
+}
+
+func (t *frequencyTracker[K]) victim() (K, bool) {
+	if len(t.counts) == 0 {
+		var zero K
+		return zero, false
+	}
+
+	// Removals can empty the minimum bucket, in which case the next one
+	// has to be looked up
+	if t.buckets[t.min] == nil {
+		t.min = 0
+		for count := range t.buckets {
+			if t.min == 0 || count < t.min {
+				t.min = count
+			}
+		}
+	}
+	first, _ := t.buckets[t.min].First()
+	return first.Key, true
+}
+
+func (t *frequencyTracker[K]) clear() {
+	clear(t.counts)
+	clear(t.buckets)
+	t.min = 0
+}
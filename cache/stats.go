@@ -0,0 +1,25 @@
+package cache
+
+// Stats counts what happened in a cache since it was created
+type Stats struct {
+	Hits        uint64
+	Misses      uint64
+	Evictions   uint64
+	Expirations uint64
+	Loads       uint64
+	LoadErrors  uint64
+}
+
+// Requests returns the number of lookups, hits and misses alike
+func (s Stats) Requests() uint64 {
+	return s.Hits + s.Misses
+}
+
+// HitRate returns the fraction of lookups that were hits, or zero if there
+// were none
+func (s Stats) HitRate() float64 {
+	if s.Requests() == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Requests())
+}
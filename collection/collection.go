@@ -1,8 +1,12 @@
 package collection
 
 import (
+	"iter"
+	"slices"
+
 	"github.com/avila-r/ego/iterator"
 	"github.com/avila-r/ego/slice"
+	"github.com/avila-r/ego/stream"
 )
 
 type Collection[T any] interface {
@@ -14,7 +18,9 @@ type Collection[T any] interface {
 	Clear()
 	Clone() Collection[T]
 	Elements() []T
+	All() iter.Seq[T]
 
+	stream.Streamable[T]
 	iterator.Iterable[T]
 }
 
@@ -106,6 +112,16 @@ func (c *DefaultCollection[T]) Clone() Collection[T] {
 	}
 }
 
+// All returns a sequence over the elements in the collection
+func (c *DefaultCollection[T]) All() iter.Seq[T] {
+	return slices.Values(c.elements)
+}
+
+// Stream returns a lazy stream over the elements in the collection
+func (c *DefaultCollection[T]) Stream() stream.Stream[T] {
+	return stream.From(c)
+}
+
 // Iterator returns an iterator over the elements in the collection
 func (c *DefaultCollection[T]) Iterator() iterator.Iterator[T] {
 	return iterator.Of(c.elements...)
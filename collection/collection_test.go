@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/avila-r/ego/collection"
+	"github.com/avila-r/ego/function"
 	"github.com/avila-r/ego/slice"
 	"github.com/stretchr/testify/assert"
 )
@@ -348,6 +349,51 @@ func Test_Iterator(t *testing.T) {
 	}
 }
 
+func Test_All(t *testing.T) {
+	type Case struct {
+		name     string
+		elements []int
+		expected []int
+	}
+
+	cases := []Case{
+		{"empty collection", []int{}, []int{}},
+		{"single element", []int{1}, []int{1}},
+		{"multiple elements", []int{1, 2, 3}, []int{1, 2, 3}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			col := collection.Of(c.elements...)
+
+			result := slice.Empty[int]()
+			for v := range col.All() {
+				result = append(result, v)
+			}
+
+			assert.Equal(t, c.expected, result)
+		})
+	}
+}
+
+func Test_Stream(t *testing.T) {
+	type document struct {
+		id   int
+		tags []string
+	}
+
+	col := collection.Of(document{1, []string{"a"}}, document{2, nil}, document{3, []string{"b"}})
+
+	ids := slice.Empty[int]()
+	col.Stream().ForEach(function.NewConsumer(func(d document) {
+		if len(d.tags) > 0 {
+			ids = append(ids, d.id)
+		}
+	}))
+
+	assert.Equal(t, []int{1, 3}, ids)
+}
+
 func Test_Collection_ComplexWorkflow(t *testing.T) {
 	// Create collection
 	col := collection.Empty[string]()
@@ -0,0 +1,29 @@
+package collection
+
+import (
+	"iter"
+
+	"github.com/avila-r/ego/iterator"
+	"github.com/avila-r/ego/stream"
+)
+
+// Deque is a double-ended queue supporting insertion, removal and inspection
+// at both ends. Iteration goes from first to last.
+type Deque[T any] interface {
+	AddFirst(element T)
+	AddLast(element T)
+	PollFirst() (T, bool)
+	PollLast() (T, bool)
+	PeekFirst() (T, bool)
+	PeekLast() (T, bool)
+	Size() int
+	IsEmpty() bool
+	Clear()
+	All() iter.Seq[T]
+	Backward() iter.Seq[T]
+	DescendingIterator() iterator.Iterator[T]
+
+	stream.Collectable[T]
+	stream.Streamable[T]
+	iterator.Iterable[T]
+}
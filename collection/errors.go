@@ -0,0 +1,10 @@
+package collection
+
+import (
+	"github.com/avila-r/ego/failure"
+)
+
+var (
+	// ErrUnmodifiable is raised by every mutator of an unmodifiable wrapper
+	ErrUnmodifiable = failure.UnsupportedOperation.New("collection is unmodifiable")
+)
@@ -1,6 +1,9 @@
 package collection
 
 import (
+	"iter"
+
+	"github.com/avila-r/ego/function"
 	"github.com/avila-r/ego/iterator"
 	"github.com/avila-r/ego/stream"
 )
@@ -14,6 +17,45 @@ type List[T comparable] interface {
 	IsEmpty() bool
 	Clear()
 	Contains(element T) bool
+	All() iter.Seq[T]
+
+	// Insert places elements before index, shifting later elements back.
+	// index may equal Size to append. It reports false if index is out of range.
+	Insert(index int, elements ...T) bool
+
+	// IndexOf returns the index of the first occurrence of element, or -1
+	IndexOf(element T) int
+
+	// LastIndexOf returns the index of the last occurrence of element, or -1
+	LastIndexOf(element T) int
+
+	// SubList returns a live view of the elements in [from, to). Changes made
+	// through the view are visible in the list and the other way around, as
+	// long as the list isn't structurally modified behind the view's back.
+	// Panics if the range is out of bounds.
+	SubList(from, to int) List[T]
+
+	// Sort stably sorts the list in place
+	Sort(comparator function.Comparator[T])
+
+	// BinarySearch looks for element in a list sorted by comparator. It
+	// returns the index where element is or would be inserted, and whether
+	// it was found.
+	BinarySearch(element T, comparator function.Comparator[T]) (int, bool)
+
+	// RemoveIf removes every element matching predicate and reports whether
+	// anything was removed
+	RemoveIf(predicate function.Predicate[T]) bool
+
+	// ReplaceAll replaces every element with the result of operator
+	ReplaceAll(operator function.UnaryOperator[T])
+
+	// RetainAll removes every element not among elements and reports whether
+	// anything was removed
+	RetainAll(elements ...T) bool
+
+	// Equals reports whether other holds the same elements in the same order
+	Equals(other List[T]) bool
 
 	stream.Collectable[T]
 	stream.Streamable[T]
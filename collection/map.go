@@ -1,6 +1,10 @@
 package collection
 
-import "github.com/avila-r/ego/iterator"
+import (
+	"iter"
+
+	"github.com/avila-r/ego/iterator"
+)
 
 type Map[K comparable, V any] interface {
 	Get(key K) (V, bool)
@@ -14,6 +18,39 @@ type Map[K comparable, V any] interface {
 	ContainsValue(value V) bool
 	Filter(func(K, V) bool) Map[K, V]
 
+	// GetOrDefault returns the value for key, or fallback if it is absent
+	GetOrDefault(key K, fallback V) V
+
+	// Compute replaces the entry for key with the result of remapping, which
+	// receives the current value and whether it exists. Returning false from
+	// remapping removes the entry. It returns the new value and whether the
+	// key is now present.
+	Compute(key K, remapping func(key K, value V, present bool) (V, bool)) (V, bool)
+
+	// ComputeIfAbsent returns the value for key, storing the result of
+	// mapping first if the key is absent
+	ComputeIfAbsent(key K, mapping func(key K) V) V
+
+	// ComputeIfPresent replaces the value for key with the result of
+	// remapping if the key is present. Returning false removes the entry.
+	ComputeIfPresent(key K, remapping func(key K, value V) (V, bool)) (V, bool)
+
+	// Merge stores value for an absent key, or the result of remapping the
+	// current value with value otherwise. Returning false removes the entry.
+	Merge(key K, value V, remapping func(old, value V) (V, bool)) (V, bool)
+
+	// ReplaceAll replaces every value with the result of function
+	ReplaceAll(function func(K, V) V)
+
+	// PutAll copies every entry of other into the map
+	PutAll(other Map[K, V])
+
+	// RemoveIf removes every entry matching predicate and reports whether
+	// anything was removed
+	RemoveIf(predicate func(K, V) bool) bool
+
+	ForEach(action func(K, V))
+
 	Clone() Map[K, V]
 	ToSlice() []Entry[K, V]
 
@@ -24,11 +61,51 @@ type Map[K comparable, V any] interface {
 	Values() Collection[V]
 	Elements() map[K]V
 	Entries() Collection[Entry[K, V]]
+	All() iter.Seq2[K, V]
+
+	Iterator() iterator.Iterator[Entry[K, V]]
+}
+
+// AnyMap is Map without the comparable key constraint, for maps keyed by
+// slices or other values Go can't compare. It has every Map method except
+// Elements, which needs a Go map, and its Filter, Clone and PutAll work with
+// AnyMaps.
+type AnyMap[K any, V any] interface {
+	Get(key K) (V, bool)
+	Put(key K, value V)
+	PutIfAbsent(key K, value V) bool
+	Delete(key K)
+	Clear()
+	Len() int
+	IsEmpty() bool
+	ContainsKey(key K) bool
+	ContainsValue(value V) bool
+	Filter(func(K, V) bool) AnyMap[K, V]
+	GetOrDefault(key K, fallback V) V
+	Compute(key K, remapping func(key K, value V, present bool) (V, bool)) (V, bool)
+	ComputeIfAbsent(key K, mapping func(key K) V) V
+	ComputeIfPresent(key K, remapping func(key K, value V) (V, bool)) (V, bool)
+	Merge(key K, value V, remapping func(old, value V) (V, bool)) (V, bool)
+	ReplaceAll(function func(K, V) V)
+	PutAll(other AnyMap[K, V])
+	RemoveIf(predicate func(K, V) bool) bool
+	ForEach(action func(K, V))
+
+	Clone() AnyMap[K, V]
+	ToSlice() []Entry[K, V]
+
+	KeySlice() []K
+	ValueSlice() []V
+
+	Keys() Collection[K]
+	Values() Collection[V]
+	Entries() Collection[Entry[K, V]]
+	All() iter.Seq2[K, V]
 
 	Iterator() iterator.Iterator[Entry[K, V]]
 }
 
-type Entry[K comparable, V any] struct {
+type Entry[K any, V any] struct {
 	Key   K
 	Value V
 }
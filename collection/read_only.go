@@ -0,0 +1,44 @@
+package collection
+
+import (
+	"iter"
+
+	"github.com/avila-r/ego/iterator"
+	"github.com/avila-r/ego/stream"
+)
+
+// ReadOnlyList is the read side of a List. Persistent and unmodifiable
+// lists implement it without exposing any mutator.
+type ReadOnlyList[T any] interface {
+	Get(index int) (T, bool)
+	Size() int
+	IsEmpty() bool
+	All() iter.Seq[T]
+
+	stream.Collectable[T]
+	stream.Streamable[T]
+	iterator.Iterable[T]
+}
+
+// ReadOnlyMap is the read side of a Map
+type ReadOnlyMap[K comparable, V any] interface {
+	Get(key K) (V, bool)
+	GetOrDefault(key K, fallback V) V
+	ContainsKey(key K) bool
+	Len() int
+	IsEmpty() bool
+	KeySlice() []K
+	ValueSlice() []V
+	ToSlice() []Entry[K, V]
+	Elements() map[K]V
+	All() iter.Seq2[K, V]
+	ForEach(action func(K, V))
+
+	Iterator() iterator.Iterator[Entry[K, V]]
+}
+
+// Every List and Map can be used where only reads are needed
+var (
+	_ ReadOnlyList[int]        = List[int](nil)
+	_ ReadOnlyMap[string, int] = Map[string, int](nil)
+)
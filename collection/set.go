@@ -1,9 +1,37 @@
 package collection
 
 import (
+	"iter"
+
+	"github.com/avila-r/ego/iterator"
 	"github.com/avila-r/ego/stream"
 )
 
-type Set[T comparable] interface {
+// Set is a collection of distinct elements with the usual set algebra.
+// Operations that build a new set return one of the receiver's kind, so
+// Union on a sorted set yields a sorted set.
+type Set[T any] interface {
+	Add(element T) bool
+	Remove(element T) bool
+	Contains(element T) bool
+	Size() int
+	IsEmpty() bool
+	Clear()
+	ToSlice() []T
+	All() iter.Seq[T]
+
+	Union(other Set[T]) Set[T]
+	Intersection(other Set[T]) Set[T]
+	Difference(other Set[T]) Set[T]
+	SymmetricDifference(other Set[T]) Set[T]
+
+	IsSubsetOf(other Set[T]) bool
+	IsSupersetOf(other Set[T]) bool
+	IsDisjoint(other Set[T]) bool
+	// Equals reports whether both sets hold the same elements, regardless
+	// of their kind or order
+	Equals(other Set[T]) bool
+
 	stream.Streamable[T]
+	iterator.Iterable[T]
 }
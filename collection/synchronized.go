@@ -0,0 +1,514 @@
+package collection
+
+import (
+	"iter"
+	"slices"
+	"sync"
+
+	"github.com/avila-r/ego/function"
+	"github.com/avila-r/ego/iterator"
+	"github.com/avila-r/ego/stream"
+)
+
+// synchronizedList guards a List with a read-write mutex. Sub lists share
+// the mutex of the list they were taken from.
+type synchronizedList[T comparable] struct {
+	mu   *sync.RWMutex
+	list List[T]
+}
+
+// synchronizedMap guards a Map with a read-write mutex
+type synchronizedMap[K comparable, V any] struct {
+	mu sync.RWMutex
+	m  Map[K, V]
+}
+
+// synchronizedSet guards a Set with a read-write mutex
+type synchronizedSet[T any] struct {
+	mu  sync.RWMutex
+	set Set[T]
+}
+
+var (
+	_ List[int]        = (*synchronizedList[int])(nil)
+	_ Map[string, int] = (*synchronizedMap[string, int])(nil)
+	_ Set[int]         = (*synchronizedSet[int])(nil)
+)
+
+// Synchronized returns a view of list that is safe for concurrent use.
+// Iteration runs over a snapshot taken when it starts, and the functions
+// passed to Sort, RemoveIf and ReplaceAll run with the lock held, so they
+// must not call back into the list. list must not be used directly anymore.
+func Synchronized[T comparable](list List[T]) List[T] {
+	if view, ok := list.(*synchronizedList[T]); ok {
+		return view
+	}
+	return &synchronizedList[T]{mu: &sync.RWMutex{}, list: list}
+}
+
+// SynchronizedMap returns a view of m that is safe for concurrent use.
+// Iteration runs over a snapshot, and the functions passed to the compute
+// family, Filter, ReplaceAll and RemoveIf run with the lock held.
+func SynchronizedMap[K comparable, V any](m Map[K, V]) Map[K, V] {
+	if view, ok := m.(*synchronizedMap[K, V]); ok {
+		return view
+	}
+	return &synchronizedMap[K, V]{m: m}
+}
+
+// SynchronizedSet returns a view of set that is safe for concurrent use.
+// Iteration runs over a snapshot, and the set algebra copies a synchronized
+// other set before taking this one's lock.
+func SynchronizedSet[T any](set Set[T]) Set[T] {
+	if view, ok := set.(*synchronizedSet[T]); ok {
+		return view
+	}
+	return &synchronizedSet[T]{set: set}
+}
+
+func (l *synchronizedList[T]) Add(elements ...T) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.list.Add(elements...)
+}
+
+func (l *synchronizedList[T]) Get(index int) (T, bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.list.Get(index)
+}
+
+func (l *synchronizedList[T]) Set(index int, element T) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.list.Set(index, element)
+}
+
+func (l *synchronizedList[T]) Remove(index int) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.list.Remove(index)
+}
+
+func (l *synchronizedList[T]) Size() int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.list.Size()
+}
+
+func (l *synchronizedList[T]) IsEmpty() bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.list.IsEmpty()
+}
+
+func (l *synchronizedList[T]) Clear() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.list.Clear()
+}
+
+func (l *synchronizedList[T]) Contains(element T) bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.list.Contains(element)
+}
+
+func (l *synchronizedList[T]) All() iter.Seq[T] {
+	return slices.Values(l.Elements())
+}
+
+func (l *synchronizedList[T]) Insert(index int, elements ...T) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.list.Insert(index, elements...)
+}
+
+func (l *synchronizedList[T]) IndexOf(element T) int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.list.IndexOf(element)
+}
+
+func (l *synchronizedList[T]) LastIndexOf(element T) int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.list.LastIndexOf(element)
+}
+
+func (l *synchronizedList[T]) SubList(from, to int) List[T] {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return &synchronizedList[T]{mu: l.mu, list: l.list.SubList(from, to)}
+}
+
+func (l *synchronizedList[T]) Sort(comparator function.Comparator[T]) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.list.Sort(comparator)
+}
+
+func (l *synchronizedList[T]) BinarySearch(element T, comparator function.Comparator[T]) (int, bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.list.BinarySearch(element, comparator)
+}
+
+func (l *synchronizedList[T]) RemoveIf(predicate function.Predicate[T]) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.list.RemoveIf(predicate)
+}
+
+func (l *synchronizedList[T]) ReplaceAll(operator function.UnaryOperator[T]) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.list.ReplaceAll(operator)
+}
+
+func (l *synchronizedList[T]) RetainAll(elements ...T) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.list.RetainAll(elements...)
+}
+
+// Equals compares snapshots of both lists, so it never holds two locks
+func (l *synchronizedList[T]) Equals(other List[T]) bool {
+	if other == nil {
+		return false
+	}
+	return slices.Equal(l.Elements(), other.Elements())
+}
+
+func (l *synchronizedList[T]) Elements() []T {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.list.Elements()
+}
+
+func (l *synchronizedList[T]) Stream() stream.Stream[T] {
+	return stream.Of(l.Elements()...)
+}
+
+func (l *synchronizedList[T]) ForEach(action func(T)) {
+	for _, element := range l.Elements() {
+		action(element)
+	}
+}
+
+func (l *synchronizedList[T]) Iterator() iterator.Iterator[T] {
+	return iterator.Of(l.Elements()...)
+}
+
+func (m *synchronizedMap[K, V]) Get(key K) (V, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.Get(key)
+}
+
+func (m *synchronizedMap[K, V]) Put(key K, value V) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.m.Put(key, value)
+}
+
+func (m *synchronizedMap[K, V]) PutIfAbsent(key K, value V) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.m.PutIfAbsent(key, value)
+}
+
+func (m *synchronizedMap[K, V]) Delete(key K) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.m.Delete(key)
+}
+
+func (m *synchronizedMap[K, V]) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.m.Clear()
+}
+
+func (m *synchronizedMap[K, V]) Len() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.Len()
+}
+
+func (m *synchronizedMap[K, V]) IsEmpty() bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.IsEmpty()
+}
+
+func (m *synchronizedMap[K, V]) ContainsKey(key K) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.ContainsKey(key)
+}
+
+func (m *synchronizedMap[K, V]) ContainsValue(value V) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.ContainsValue(value)
+}
+
+func (m *synchronizedMap[K, V]) Filter(predicate func(K, V) bool) Map[K, V] {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.Filter(predicate)
+}
+
+func (m *synchronizedMap[K, V]) GetOrDefault(key K, fallback V) V {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.GetOrDefault(key, fallback)
+}
+
+func (m *synchronizedMap[K, V]) Compute(key K, remapping func(key K, value V, present bool) (V, bool)) (V, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.m.Compute(key, remapping)
+}
+
+func (m *synchronizedMap[K, V]) ComputeIfAbsent(key K, mapping func(key K) V) V {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.m.ComputeIfAbsent(key, mapping)
+}
+
+func (m *synchronizedMap[K, V]) ComputeIfPresent(key K, remapping func(key K, value V) (V, bool)) (V, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.m.ComputeIfPresent(key, remapping)
+}
+
+func (m *synchronizedMap[K, V]) Merge(key K, value V, remapping func(old, value V) (V, bool)) (V, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.m.Merge(key, value, remapping)
+}
+
+func (m *synchronizedMap[K, V]) ReplaceAll(function func(K, V) V) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.m.ReplaceAll(function)
+}
+
+// PutAll snapshots other before taking the lock, so it never holds two locks
+func (m *synchronizedMap[K, V]) PutAll(other Map[K, V]) {
+	entries := other.ToSlice()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, entry := range entries {
+		m.m.Put(entry.Key, entry.Value)
+	}
+}
+
+func (m *synchronizedMap[K, V]) RemoveIf(predicate func(K, V) bool) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.m.RemoveIf(predicate)
+}
+
+func (m *synchronizedMap[K, V]) ForEach(action func(K, V)) {
+	for _, entry := range m.ToSlice() {
+		action(entry.Key, entry.Value)
+	}
+}
+
+func (m *synchronizedMap[K, V]) Clone() Map[K, V] {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.Clone()
+}
+
+func (m *synchronizedMap[K, V]) ToSlice() []Entry[K, V] {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.ToSlice()
+}
+
+func (m *synchronizedMap[K, V]) KeySlice() []K {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.KeySlice()
+}
+
+func (m *synchronizedMap[K, V]) ValueSlice() []V {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.ValueSlice()
+}
+
+func (m *synchronizedMap[K, V]) Keys() Collection[K] {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.Keys()
+}
+
+func (m *synchronizedMap[K, V]) Values() Collection[V] {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.Values()
+}
+
+func (m *synchronizedMap[K, V]) Elements() map[K]V {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.Elements()
+}
+
+func (m *synchronizedMap[K, V]) Entries() Collection[Entry[K, V]] {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.Entries()
+}
+
+func (m *synchronizedMap[K, V]) All() iter.Seq2[K, V] {
+	entries := m.ToSlice()
+	return func(yield func(K, V) bool) {
+		for _, entry := range entries {
+			if !yield(entry.Key, entry.Value) {
+				return
+			}
+		}
+	}
+}
+
+func (m *synchronizedMap[K, V]) Iterator() iterator.Iterator[Entry[K, V]] {
+	return iterator.Of(m.ToSlice()...)
+}
+
+func (s *synchronizedSet[T]) Add(element T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.set.Add(element)
+}
+
+func (s *synchronizedSet[T]) Remove(element T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.set.Remove(element)
+}
+
+func (s *synchronizedSet[T]) Contains(element T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Contains(element)
+}
+
+func (s *synchronizedSet[T]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Size()
+}
+
+func (s *synchronizedSet[T]) IsEmpty() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.IsEmpty()
+}
+
+func (s *synchronizedSet[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.Clear()
+}
+
+func (s *synchronizedSet[T]) ToSlice() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.ToSlice()
+}
+
+func (s *synchronizedSet[T]) All() iter.Seq[T] {
+	return slices.Values(s.ToSlice())
+}
+
+func (s *synchronizedSet[T]) Union(other Set[T]) Set[T] {
+	other = detach(other)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Union(other)
+}
+
+func (s *synchronizedSet[T]) Intersection(other Set[T]) Set[T] {
+	other = detach(other)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Intersection(other)
+}
+
+func (s *synchronizedSet[T]) Difference(other Set[T]) Set[T] {
+	other = detach(other)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Difference(other)
+}
+
+func (s *synchronizedSet[T]) SymmetricDifference(other Set[T]) Set[T] {
+	other = detach(other)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.SymmetricDifference(other)
+}
+
+func (s *synchronizedSet[T]) IsSubsetOf(other Set[T]) bool {
+	other = detach(other)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.IsSubsetOf(other)
+}
+
+func (s *synchronizedSet[T]) IsSupersetOf(other Set[T]) bool {
+	other = detach(other)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.IsSupersetOf(other)
+}
+
+func (s *synchronizedSet[T]) IsDisjoint(other Set[T]) bool {
+	other = detach(other)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.IsDisjoint(other)
+}
+
+func (s *synchronizedSet[T]) Equals(other Set[T]) bool {
+	other = detach(other)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Equals(other)
+}
+
+func (s *synchronizedSet[T]) Stream() stream.Stream[T] {
+	return stream.Of(s.ToSlice()...)
+}
+
+func (s *synchronizedSet[T]) ForEach(action func(T)) {
+	for _, element := range s.ToSlice() {
+		action(element)
+	}
+}
+
+func (s *synchronizedSet[T]) Iterator() iterator.Iterator[T] {
+	return iterator.Of(s.ToSlice()...)
+}
+
+// detach returns a plain copy of other when reading it would take another
+// lock, so the set algebra never holds two locks at once. The union of a set
+// with itself is a fresh set of the same kind.
+func detach[T any](other Set[T]) Set[T] {
+	switch view := other.(type) {
+	case *synchronizedSet[T]:
+		view.mu.RLock()
+		defer view.mu.RUnlock()
+		return view.set.Union(view.set)
+	case *unmodifiableSet[T]:
+		return detach(view.set)
+	}
+	return other
+}
@@ -0,0 +1,118 @@
+package collection_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/avila-r/ego/collection"
+	"github.com/avila-r/ego/list"
+	"github.com/avila-r/ego/maps"
+	"github.com/avila-r/ego/set"
+	"github.com/stretchr/testify/assert"
+)
+
+// concurrently runs work from several goroutines and waits for all of them
+func concurrently(work func(worker int)) {
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			work(i)
+		}()
+	}
+	wg.Wait()
+}
+
+func Test_Synchronized(t *testing.T) {
+	l := collection.Synchronized[int](list.EmptyArrayList[int]())
+
+	concurrently(func(worker int) {
+		for i := range 100 {
+			l.Add(worker*100 + i)
+			l.Contains(i)
+			for range l.All() {
+			}
+		}
+	})
+	assert.Equal(t, 800, l.Size())
+
+	t.Run("sub list shares the lock", func(t *testing.T) {
+		view := l.SubList(0, 100)
+		concurrently(func(int) {
+			view.Set(0, -1)
+			l.Get(0)
+		})
+		first, _ := l.Get(0)
+		assert.Equal(t, -1, first)
+	})
+
+	t.Run("iteration may write back", func(t *testing.T) {
+		small := collection.Synchronized[int](list.NewArrayList(1, 2))
+		small.ForEach(func(v int) { small.Add(v) })
+		assert.Equal(t, []int{1, 2, 1, 2}, small.Elements())
+		assert.True(t, small.Equals(small))
+	})
+}
+
+func Test_SynchronizedMap(t *testing.T) {
+	m := collection.SynchronizedMap[int, int](maps.NewHashMap[int, int]())
+
+	concurrently(func(worker int) {
+		for i := range 100 {
+			m.Merge(i, 1, func(old, value int) (int, bool) { return old + value, true })
+			m.Get(i)
+			for range m.All() {
+			}
+		}
+	})
+
+	assert.Equal(t, 100, m.Len())
+	for _, count := range m.ValueSlice() {
+		assert.Equal(t, 8, count)
+	}
+
+	m.PutAll(m)
+	assert.Equal(t, 100, m.Len())
+}
+
+func Test_SynchronizedSet(t *testing.T) {
+	s := collection.SynchronizedSet[int](set.NewHashSet[int]())
+
+	concurrently(func(worker int) {
+		for i := range 100 {
+			s.Add(i)
+			s.Contains(worker)
+			s.IsSubsetOf(s)
+		}
+	})
+
+	assert.Equal(t, 100, s.Size())
+	assert.True(t, s.Equals(s))
+	assert.Equal(t, 100, s.Union(s).Size())
+}
+
+func Test_SynchronizedSet_CrossAlgebra(t *testing.T) {
+	a := collection.SynchronizedSet[int](set.NewHashSet[int]())
+	b := collection.SynchronizedSet[int](set.NewHashSet[int]())
+
+	// Readers of one set never wait on the other's lock, so queued writers
+	// can't deadlock the pair
+	concurrently(func(worker int) {
+		for i := range 200 {
+			switch worker % 4 {
+			case 0:
+				a.Union(b)
+			case 1:
+				b.IsSubsetOf(a)
+			case 2:
+				a.Add(i)
+			default:
+				b.Add(i)
+			}
+		}
+	})
+
+	assert.True(t, a.Equals(collection.UnmodifiableSet(b)))
+	assert.Equal(t, 200, a.Intersection(b).Size())
+}
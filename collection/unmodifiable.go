@@ -0,0 +1,360 @@
+package collection
+
+import (
+	"iter"
+
+	"github.com/avila-r/ego/function"
+	"github.com/avila-r/ego/iterator"
+	"github.com/avila-r/ego/stream"
+)
+
+// unmodifiableList is a read-only view of a List. Reads go through to the
+// wrapped list, so later changes made to it are visible; mutators panic with
+// ErrUnmodifiable.
+type unmodifiableList[T comparable] struct {
+	list List[T]
+}
+
+// unmodifiableMap is a read-only view of a Map
+type unmodifiableMap[K comparable, V any] struct {
+	m Map[K, V]
+}
+
+// unmodifiableSet is a read-only view of a Set
+type unmodifiableSet[T any] struct {
+	set Set[T]
+}
+
+var (
+	_ List[int]        = (*unmodifiableList[int])(nil)
+	_ Map[string, int] = (*unmodifiableMap[string, int])(nil)
+	_ Set[int]         = (*unmodifiableSet[int])(nil)
+)
+
+// Unmodifiable returns a read-only view of list. Every mutator, including
+// those of its sub lists, panics with ErrUnmodifiable.
+func Unmodifiable[T comparable](list List[T]) List[T] {
+	if view, ok := list.(*unmodifiableList[T]); ok {
+		return view
+	}
+	return &unmodifiableList[T]{list: list}
+}
+
+// UnmodifiableMap returns a read-only view of m. Every mutator panics with
+// ErrUnmodifiable; Filter and Clone still return fresh, modifiable maps.
+func UnmodifiableMap[K comparable, V any](m Map[K, V]) Map[K, V] {
+	if view, ok := m.(*unmodifiableMap[K, V]); ok {
+		return view
+	}
+	return &unmodifiableMap[K, V]{m: m}
+}
+
+// UnmodifiableSet returns a read-only view of set. Every mutator panics with
+// ErrUnmodifiable; the set algebra still returns fresh, modifiable sets.
+func UnmodifiableSet[T any](set Set[T]) Set[T] {
+	if view, ok := set.(*unmodifiableSet[T]); ok {
+		return view
+	}
+	return &unmodifiableSet[T]{set: set}
+}
+
+func (l *unmodifiableList[T]) Add(...T) {
+	ErrUnmodifiable.Panic()
+}
+
+func (l *unmodifiableList[T]) Get(index int) (T, bool) {
+	return l.list.Get(index)
+}
+
+func (l *unmodifiableList[T]) Set(int, T) bool {
+	ErrUnmodifiable.Panic()
+	return false
+}
+
+func (l *unmodifiableList[T]) Remove(int) bool {
+	ErrUnmodifiable.Panic()
+	return false
+}
+
+func (l *unmodifiableList[T]) Size() int {
+	return l.list.Size()
+}
+
+func (l *unmodifiableList[T]) IsEmpty() bool {
+	return l.list.IsEmpty()
+}
+
+func (l *unmodifiableList[T]) Clear() {
+	ErrUnmodifiable.Panic()
+}
+
+func (l *unmodifiableList[T]) Contains(element T) bool {
+	return l.list.Contains(element)
+}
+
+func (l *unmodifiableList[T]) All() iter.Seq[T] {
+	return l.list.All()
+}
+
+func (l *unmodifiableList[T]) Insert(int, ...T) bool {
+	ErrUnmodifiable.Panic()
+	return false
+}
+
+func (l *unmodifiableList[T]) IndexOf(element T) int {
+	return l.list.IndexOf(element)
+}
+
+func (l *unmodifiableList[T]) LastIndexOf(element T) int {
+	return l.list.LastIndexOf(element)
+}
+
+func (l *unmodifiableList[T]) SubList(from, to int) List[T] {
+	return Unmodifiable(l.list.SubList(from, to))
+}
+
+func (l *unmodifiableList[T]) Sort(function.Comparator[T]) {
+	ErrUnmodifiable.Panic()
+}
+
+func (l *unmodifiableList[T]) BinarySearch(element T, comparator function.Comparator[T]) (int, bool) {
+	return l.list.BinarySearch(element, comparator)
+}
+
+func (l *unmodifiableList[T]) RemoveIf(function.Predicate[T]) bool {
+	ErrUnmodifiable.Panic()
+	return false
+}
+
+func (l *unmodifiableList[T]) ReplaceAll(function.UnaryOperator[T]) {
+	ErrUnmodifiable.Panic()
+}
+
+func (l *unmodifiableList[T]) RetainAll(...T) bool {
+	ErrUnmodifiable.Panic()
+	return false
+}
+
+func (l *unmodifiableList[T]) Equals(other List[T]) bool {
+	return l.list.Equals(other)
+}
+
+func (l *unmodifiableList[T]) Elements() []T {
+	return l.list.Elements()
+}
+
+func (l *unmodifiableList[T]) Stream() stream.Stream[T] {
+	return l.list.Stream()
+}
+
+func (l *unmodifiableList[T]) ForEach(action func(T)) {
+	l.list.ForEach(action)
+}
+
+func (l *unmodifiableList[T]) Iterator() iterator.Iterator[T] {
+	return l.list.Iterator()
+}
+
+func (m *unmodifiableMap[K, V]) Get(key K) (V, bool) {
+	return m.m.Get(key)
+}
+
+func (m *unmodifiableMap[K, V]) Put(K, V) {
+	ErrUnmodifiable.Panic()
+}
+
+func (m *unmodifiableMap[K, V]) PutIfAbsent(K, V) bool {
+	ErrUnmodifiable.Panic()
+	return false
+}
+
+func (m *unmodifiableMap[K, V]) Delete(K) {
+	ErrUnmodifiable.Panic()
+}
+
+func (m *unmodifiableMap[K, V]) Clear() {
+	ErrUnmodifiable.Panic()
+}
+
+func (m *unmodifiableMap[K, V]) Len() int {
+	return m.m.Len()
+}
+
+func (m *unmodifiableMap[K, V]) IsEmpty() bool {
+	return m.m.IsEmpty()
+}
+
+func (m *unmodifiableMap[K, V]) ContainsKey(key K) bool {
+	return m.m.ContainsKey(key)
+}
+
+func (m *unmodifiableMap[K, V]) ContainsValue(value V) bool {
+	return m.m.ContainsValue(value)
+}
+
+func (m *unmodifiableMap[K, V]) Filter(predicate func(K, V) bool) Map[K, V] {
+	return m.m.Filter(predicate)
+}
+
+func (m *unmodifiableMap[K, V]) GetOrDefault(key K, fallback V) V {
+	return m.m.GetOrDefault(key, fallback)
+}
+
+func (m *unmodifiableMap[K, V]) Compute(K, func(K, V, bool) (V, bool)) (V, bool) {
+	ErrUnmodifiable.Panic()
+	var zero V
+	return zero, false
+}
+
+func (m *unmodifiableMap[K, V]) ComputeIfAbsent(K, func(K) V) V {
+	ErrUnmodifiable.Panic()
+	var zero V
+	return zero
+}
+
+func (m *unmodifiableMap[K, V]) ComputeIfPresent(K, func(K, V) (V, bool)) (V, bool) {
+	ErrUnmodifiable.Panic()
+	var zero V
+	return zero, false
+}
+
+func (m *unmodifiableMap[K, V]) Merge(K, V, func(V, V) (V, bool)) (V, bool) {
+	ErrUnmodifiable.Panic()
+	var zero V
+	return zero, false
+}
+
+func (m *unmodifiableMap[K, V]) ReplaceAll(func(K, V) V) {
+	ErrUnmodifiable.Panic()
+}
+
+func (m *unmodifiableMap[K, V]) PutAll(Map[K, V]) {
+	ErrUnmodifiable.Panic()
+}
+
+func (m *unmodifiableMap[K, V]) RemoveIf(func(K, V) bool) bool {
+	ErrUnmodifiable.Panic()
+	return false
+}
+
+func (m *unmodifiableMap[K, V]) ForEach(action func(K, V)) {
+	m.m.ForEach(action)
+}
+
+func (m *unmodifiableMap[K, V]) Clone() Map[K, V] {
+	return m.m.Clone()
+}
+
+func (m *unmodifiableMap[K, V]) ToSlice() []Entry[K, V] {
+	return m.m.ToSlice()
+}
+
+func (m *unmodifiableMap[K, V]) KeySlice() []K {
+	return m.m.KeySlice()
+}
+
+func (m *unmodifiableMap[K, V]) ValueSlice() []V {
+	return m.m.ValueSlice()
+}
+
+func (m *unmodifiableMap[K, V]) Keys() Collection[K] {
+	return m.m.Keys()
+}
+
+func (m *unmodifiableMap[K, V]) Values() Collection[V] {
+	return m.m.Values()
+}
+
+func (m *unmodifiableMap[K, V]) Elements() map[K]V {
+	return m.m.Elements()
+}
+
+func (m *unmodifiableMap[K, V]) Entries() Collection[Entry[K, V]] {
+	return m.m.Entries()
+}
+
+func (m *unmodifiableMap[K, V]) All() iter.Seq2[K, V] {
+	return m.m.All()
+}
+
+func (m *unmodifiableMap[K, V]) Iterator() iterator.Iterator[Entry[K, V]] {
+	return m.m.Iterator()
+}
+
+func (s *unmodifiableSet[T]) Add(T) bool {
+	ErrUnmodifiable.Panic()
+	return false
+}
+
+func (s *unmodifiableSet[T]) Remove(T) bool {
+	ErrUnmodifiable.Panic()
+	return false
+}
+
+func (s *unmodifiableSet[T]) Contains(element T) bool {
+	return s.set.Contains(element)
+}
+
+func (s *unmodifiableSet[T]) Size() int {
+	return s.set.Size()
+}
+
+func (s *unmodifiableSet[T]) IsEmpty() bool {
+	return s.set.IsEmpty()
+}
+
+func (s *unmodifiableSet[T]) Clear() {
+	ErrUnmodifiable.Panic()
+}
+
+func (s *unmodifiableSet[T]) ToSlice() []T {
+	return s.set.ToSlice()
+}
+
+func (s *unmodifiableSet[T]) All() iter.Seq[T] {
+	return s.set.All()
+}
+
+func (s *unmodifiableSet[T]) Union(other Set[T]) Set[T] {
+	return s.set.Union(other)
+}
+
+func (s *unmodifiableSet[T]) Intersection(other Set[T]) Set[T] {
+	return s.set.Intersection(other)
+}
+
+func (s *unmodifiableSet[T]) Difference(other Set[T]) Set[T] {
+	return s.set.Difference(other)
+}
+
+func (s *unmodifiableSet[T]) SymmetricDifference(other Set[T]) Set[T] {
+	return s.set.SymmetricDifference(other)
+}
+
+func (s *unmodifiableSet[T]) IsSubsetOf(other Set[T]) bool {
+	return s.set.IsSubsetOf(other)
+}
+
+func (s *unmodifiableSet[T]) IsSupersetOf(other Set[T]) bool {
+	return s.set.IsSupersetOf(other)
+}
+
+func (s *unmodifiableSet[T]) IsDisjoint(other Set[T]) bool {
+	return s.set.IsDisjoint(other)
+}
+
+func (s *unmodifiableSet[T]) Equals(other Set[T]) bool {
+	return s.set.Equals(other)
+}
+
+func (s *unmodifiableSet[T]) Stream() stream.Stream[T] {
+	return s.set.Stream()
+}
+
+func (s *unmodifiableSet[T]) ForEach(action func(T)) {
+	s.set.ForEach(action)
+}
+
+func (s *unmodifiableSet[T]) Iterator() iterator.Iterator[T] {
+	return s.set.Iterator()
+}
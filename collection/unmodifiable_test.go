@@ -0,0 +1,142 @@
+package collection_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/avila-r/ego/collection"
+	"github.com/avila-r/ego/failure"
+	"github.com/avila-r/ego/function"
+	"github.com/avila-r/ego/list"
+	"github.com/avila-r/ego/maps"
+	"github.com/avila-r/ego/set"
+	"github.com/stretchr/testify/assert"
+)
+
+// assertUnmodifiable checks that mutate panics with ErrUnmodifiable
+func assertUnmodifiable(t *testing.T, mutate func()) {
+	t.Helper()
+
+	defer func() {
+		t.Helper()
+		err, ok := recover().(*failure.Failure)
+		if assert.True(t, ok, "expected a failure panic") {
+			assert.True(t, err.Extends(failure.UnsupportedOperation))
+			assert.ErrorIs(t, err, collection.ErrUnmodifiable)
+		}
+	}()
+	mutate()
+}
+
+func Test_Unmodifiable(t *testing.T) {
+	backing := list.NewArrayList(3, 1, 2)
+	view := collection.Unmodifiable[int](backing)
+
+	type Case struct {
+		name   string
+		mutate func()
+	}
+
+	cases := []Case{
+		{"add", func() { view.Add(4) }},
+		{"set", func() { view.Set(0, 4) }},
+		{"remove", func() { view.Remove(0) }},
+		{"clear", func() { view.Clear() }},
+		{"insert", func() { view.Insert(0, 4) }},
+		{"sort", func() { view.Sort(function.NewComparator(func(a, b int) int { return a - b })) }},
+		{"remove if", func() { view.RemoveIf(function.NewPredicate(func(int) bool { return true })) }},
+		{"replace all", func() { view.ReplaceAll(function.NewUnaryOperator(func(v int) int { return v })) }},
+		{"retain all", func() { view.RetainAll(1) }},
+		{"sub list", func() { view.SubList(0, 2).Add(4) }},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assertUnmodifiable(t, c.mutate)
+		})
+	}
+
+	t.Run("reads go through", func(t *testing.T) {
+		backing.Add(4)
+		assert.Equal(t, 4, view.Size())
+		assert.Equal(t, []int{3, 1, 2, 4}, view.Elements())
+		assert.Equal(t, 2, view.IndexOf(2))
+		assert.True(t, view.Contains(4))
+		assert.True(t, view.Equals(backing))
+		assert.Equal(t, []int{3, 1, 2, 4}, slices.Collect(view.All()))
+	})
+
+	t.Run("elements are a copy", func(t *testing.T) {
+		view.Elements()[0] = 100
+		first, _ := view.Get(0)
+		assert.Equal(t, 3, first)
+	})
+
+	t.Run("wrapping twice", func(t *testing.T) {
+		assert.Same(t, view, collection.Unmodifiable(view))
+	})
+}
+
+func Test_UnmodifiableMap(t *testing.T) {
+	backing := maps.NewHashMap[string, int]()
+	backing.Put("a", 1)
+	view := collection.UnmodifiableMap[string, int](backing)
+
+	type Case struct {
+		name   string
+		mutate func()
+	}
+
+	cases := []Case{
+		{"put", func() { view.Put("b", 2) }},
+		{"put if absent", func() { view.PutIfAbsent("b", 2) }},
+		{"delete", func() { view.Delete("a") }},
+		{"clear", func() { view.Clear() }},
+		{"compute", func() { view.Compute("a", func(string, int, bool) (int, bool) { return 0, true }) }},
+		{"compute if absent", func() { view.ComputeIfAbsent("b", func(string) int { return 0 }) }},
+		{"compute if present", func() { view.ComputeIfPresent("a", func(string, int) (int, bool) { return 0, true }) }},
+		{"merge", func() { view.Merge("a", 1, func(a, b int) (int, bool) { return a + b, true }) }},
+		{"replace all", func() { view.ReplaceAll(func(string, int) int { return 0 }) }},
+		{"put all", func() { view.PutAll(maps.NewHashMap[string, int]()) }},
+		{"remove if", func() { view.RemoveIf(func(string, int) bool { return true }) }},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assertUnmodifiable(t, c.mutate)
+		})
+	}
+
+	t.Run("reads go through", func(t *testing.T) {
+		backing.Put("b", 2)
+		assert.Equal(t, 2, view.Len())
+		assert.Equal(t, 2, view.GetOrDefault("b", 0))
+		assert.Equal(t, map[string]int{"a": 1, "b": 2}, view.Elements())
+	})
+
+	t.Run("clone is modifiable", func(t *testing.T) {
+		clone := view.Clone()
+		clone.Put("c", 3)
+		assert.Equal(t, 3, clone.Len())
+		assert.False(t, view.ContainsKey("c"))
+	})
+}
+
+func Test_UnmodifiableSet(t *testing.T) {
+	backing := set.NewSet([]int{1, 2})
+	view := collection.UnmodifiableSet[int](backing)
+
+	assertUnmodifiable(t, func() { view.Add(3) })
+	assertUnmodifiable(t, func() { view.Remove(1) })
+	assertUnmodifiable(t, func() { view.Clear() })
+
+	backing.Add(3)
+	assert.Equal(t, 3, view.Size())
+	assert.True(t, view.Contains(3))
+	assert.True(t, view.Equals(backing))
+
+	union := view.Union(set.NewSet([]int{4}))
+	assert.True(t, union.Add(5))
+	assert.Equal(t, 5, union.Size())
+	assert.Equal(t, 3, view.Size())
+}
@@ -0,0 +1,82 @@
+// Package collectors provides ready-made stream.Collector implementations
+// that gather stream elements into ego collections and summary values.
+package collectors
+
+import (
+	"github.com/avila-r/ego/function"
+	"github.com/avila-r/ego/list"
+	"github.com/avila-r/ego/maps"
+	"github.com/avila-r/ego/set"
+	"github.com/avila-r/ego/stream"
+)
+
+// ToList collects elements into an ArrayList in encounter order
+func ToList[T comparable]() stream.Collector[T, *list.ArrayList[T], *list.ArrayList[T]] {
+	return stream.CollectorOf(
+		function.NewSupplier(list.EmptyArrayList[T]),
+		function.NewBiConsumer(func(l *list.ArrayList[T], element T) {
+			l.Add(element)
+		}),
+		function.NewBinaryOperator(func(a, b *list.ArrayList[T]) *list.ArrayList[T] {
+			a.Add(b.Elements()...)
+			return a
+		}),
+	)
+}
+
+// ToSet collects elements into a HashSet
+func ToSet[T comparable]() stream.Collector[T, *set.HashSet[T], *set.HashSet[T]] {
+	return stream.CollectorOf(
+		function.NewSupplier(set.NewHashSet[T]),
+		function.NewBiConsumer(func(s *set.HashSet[T], element T) {
+			s.Add(element)
+		}),
+		function.NewBinaryOperator(func(a, b *set.HashSet[T]) *set.HashSet[T] {
+			for element := range b.All() {
+				a.Add(element)
+			}
+			return a
+		}),
+	)
+}
+
+// ToMap collects elements into a HashMap using the given key and value
+// mappers. When two elements map to the same key the later value wins.
+func ToMap[T any, K comparable, V any](
+	keyMapper function.Function[T, K],
+	valueMapper function.Function[T, V],
+) stream.Collector[T, *maps.HashMap[K, V], *maps.HashMap[K, V]] {
+	return stream.CollectorOf(
+		function.NewSupplier(maps.NewHashMap[K, V]),
+		function.NewBiConsumer(func(m *maps.HashMap[K, V], element T) {
+			m.Put(keyMapper.Apply(element), valueMapper.Apply(element))
+		}),
+		function.NewBinaryOperator(func(a, b *maps.HashMap[K, V]) *maps.HashMap[K, V] {
+			for k, v := range b.All() {
+				a.Put(k, v)
+			}
+			return a
+		}),
+	)
+}
+
+// ToLinkedMap collects elements into a LinkedHashMap that keeps the encounter
+// order of the keys. When two elements map to the same key the later value
+// wins and the key keeps its original position.
+func ToLinkedMap[T any, K comparable, V any](
+	keyMapper function.Function[T, K],
+	valueMapper function.Function[T, V],
+) stream.Collector[T, *maps.LinkedHashMap[K, V], *maps.LinkedHashMap[K, V]] {
+	return stream.CollectorOf(
+		function.NewSupplier(maps.NewLinkedHashMap[K, V]),
+		function.NewBiConsumer(func(m *maps.LinkedHashMap[K, V], element T) {
+			m.Put(keyMapper.Apply(element), valueMapper.Apply(element))
+		}),
+		function.NewBinaryOperator(func(a, b *maps.LinkedHashMap[K, V]) *maps.LinkedHashMap[K, V] {
+			for k, v := range b.All() {
+				a.Put(k, v)
+			}
+			return a
+		}),
+	)
+}
@@ -0,0 +1,192 @@
+package collectors_test
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/avila-r/ego/collectors"
+	"github.com/avila-r/ego/function"
+	"github.com/avila-r/ego/list"
+	"github.com/avila-r/ego/stream"
+	"github.com/stretchr/testify/assert"
+)
+
+type person struct {
+	name string
+	city string
+	age  int
+}
+
+var people = []person{
+	{"ana", "lisbon", 31},
+	{"bruno", "porto", 25},
+	{"carla", "lisbon", 40},
+	{"diego", "braga", 19},
+}
+
+var (
+	byCity  = function.NewFunction(func(p person) string { return p.city })
+	byName  = function.NewFunction(func(p person) string { return p.name })
+	byAge   = function.NewFunction(func(p person) int { return p.age })
+	isAdult = function.NewPredicate(func(p person) bool { return p.age >= 21 })
+)
+
+func Test_ToList(t *testing.T) {
+	result := stream.Collect(stream.Of(3, 1, 2), collectors.ToList[int]())
+
+	assert.IsType(t, &list.ArrayList[int]{}, result)
+	assert.Equal(t, []int{3, 1, 2}, result.Elements())
+}
+
+func Test_ToSet(t *testing.T) {
+	result := stream.Collect(stream.Of(1, 2, 1, 3, 2), collectors.ToSet[int]())
+
+	elements := result.ToSlice()
+	slices.Sort(elements)
+	assert.Equal(t, []int{1, 2, 3}, elements)
+}
+
+func Test_ToMap(t *testing.T) {
+	result := stream.Collect(stream.Of(people...), collectors.ToMap(byName, byAge))
+
+	assert.Equal(t, 4, result.Len())
+	age, ok := result.Get("carla")
+	assert.True(t, ok)
+	assert.Equal(t, 40, age)
+
+	last := stream.Collect(stream.Of(people...), collectors.ToMap(byCity, byName))
+	name, _ := last.Get("lisbon")
+	assert.Equal(t, "carla", name)
+}
+
+func Test_ToLinkedMap(t *testing.T) {
+	result := stream.Collect(stream.Of(people...), collectors.ToLinkedMap(byCity, byName))
+
+	assert.Equal(t, []string{"lisbon", "porto", "braga"}, result.KeySlice())
+	assert.Equal(t, []string{"carla", "bruno", "diego"}, result.ValueSlice())
+}
+
+func Test_GroupingBy(t *testing.T) {
+	result := stream.Collect(stream.Of(people...), collectors.GroupingBy(byCity, collectors.Counting[person]()))
+
+	assert.Equal(t, map[string]int64{"lisbon": 2, "porto": 1, "braga": 1}, result.Elements())
+}
+
+func Test_GroupingBy_NestedDownstream(t *testing.T) {
+	names := stream.Collect(stream.Of(people...), collectors.GroupingBy(byCity, collectors.ToList[person]()))
+	lisbon, _ := names.Get("lisbon")
+	assert.Equal(t, []person{people[0], people[2]}, lisbon.Elements())
+
+	nested := stream.Collect(stream.Of(people...), collectors.GroupingBy(byCity,
+		collectors.PartitioningBy(isAdult, collectors.Counting[person]())))
+	braga, _ := nested.Get("braga")
+	adults, _ := braga.Get(true)
+	minors, _ := braga.Get(false)
+	assert.Equal(t, int64(0), adults)
+	assert.Equal(t, int64(1), minors)
+}
+
+func Test_PartitioningBy(t *testing.T) {
+	result := stream.Collect(stream.Of(people...), collectors.PartitioningBy(isAdult, collectors.Counting[person]()))
+
+	assert.Equal(t, map[bool]int64{true: 3, false: 1}, result.Elements())
+
+	empty := stream.Collect(stream.Empty[person](), collectors.PartitioningBy(isAdult, collectors.Counting[person]()))
+	assert.Equal(t, map[bool]int64{true: 0, false: 0}, empty.Elements())
+}
+
+func Test_Joining(t *testing.T) {
+	type Case struct {
+		name     string
+		elements []string
+		expected string
+	}
+
+	cases := []Case{
+		{"empty stream", []string{}, "[]"},
+		{"single element", []string{"a"}, "[a]"},
+		{"multiple elements", []string{"a", "b", "c"}, "[a, b, c]"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, stream.Collect(stream.Of(c.elements...), collectors.JoiningWith(", ", "[", "]")))
+		})
+	}
+
+	assert.Equal(t, "a-b", stream.Collect(stream.Of("a", "b"), collectors.Joining("-")))
+}
+
+func Test_Counting(t *testing.T) {
+	assert.Equal(t, int64(4), stream.Collect(stream.Of(people...), collectors.Counting[person]()))
+	assert.Equal(t, int64(0), stream.Collect(stream.Empty[person](), collectors.Counting[person]()))
+}
+
+func Test_SummingBy(t *testing.T) {
+	assert.Equal(t, 115, stream.Collect(stream.Of(people...), collectors.SummingBy(byAge)))
+}
+
+func Test_AveragingBy(t *testing.T) {
+	assert.Equal(t, 28.75, stream.Collect(stream.Of(people...), collectors.AveragingBy(byAge)))
+	assert.Equal(t, 0.0, stream.Collect(stream.Empty[person](), collectors.AveragingBy(byAge)))
+}
+
+func Test_SummaryStatistics(t *testing.T) {
+	statistics := stream.Collect(stream.Of(people...), collectors.SummaryStatistics(byAge))
+
+	assert.Equal(t, int64(4), statistics.Count())
+	assert.Equal(t, 115, statistics.Sum())
+	assert.Equal(t, 19, statistics.Min())
+	assert.Equal(t, 40, statistics.Max())
+	assert.Equal(t, 28.75, statistics.Average())
+}
+
+func Test_Parallel(t *testing.T) {
+	source := make([]int, 5000)
+	for i := range source {
+		source[i] = i
+	}
+	s := stream.Of(source...).Parallel(4)
+
+	assert.Equal(t, source, stream.Collect(s, collectors.ToList[int]()).Elements())
+	assert.Equal(t, 5000, stream.Collect(s, collectors.ToSet[int]()).Size())
+	assert.Equal(t, int64(5000), stream.Collect(s, collectors.Counting[int]()))
+
+	parity := function.NewFunction(func(n int) string { return strconv.Itoa(n % 2) })
+	groups := stream.Collect(s, collectors.GroupingBy(parity, collectors.SummingBy(function.IdentityFunction[int]())))
+	assert.Equal(t, map[string]int{"0": 6247500, "1": 6250000}, groups.Elements())
+
+	statistics := stream.Collect(s, collectors.SummaryStatistics(function.IdentityFunction[int]()))
+	assert.Equal(t, 0, statistics.Min())
+	assert.Equal(t, 4999, statistics.Max())
+}
+
+func Test_GroupingBy_DownstreamWithoutCombiner(t *testing.T) {
+	source := make([]int, 5000)
+	for i := range source {
+		source[i] = i
+	}
+
+	// Appends in encounter order and can't be merged
+	ordered := stream.NewCollector(
+		function.NewSupplier(func() *[]int { return &[]int{} }),
+		function.NewBiConsumer(func(acc *[]int, n int) { *acc = append(*acc, n) }),
+		nil,
+		function.NewFunction(func(acc *[]int) []int { return *acc }),
+	)
+
+	parity := function.NewFunction(func(n int) int { return n % 2 })
+	grouping := collectors.GroupingBy(parity, ordered)
+	assert.Nil(t, grouping.Combiner())
+
+	groups := stream.Collect(stream.Of(source...).Parallel(4), grouping)
+	evens, _ := groups.Get(0)
+	assert.Len(t, evens, 2500)
+	assert.True(t, slices.IsSorted(evens))
+
+	partitions := stream.Collect(stream.Of(source...).Parallel(4),
+		collectors.PartitioningBy(function.NewPredicate(func(n int) bool { return n < 10 }), ordered))
+	small, _ := partitions.Get(true)
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, small)
+}
@@ -0,0 +1,70 @@
+package collectors
+
+import (
+	"github.com/avila-r/ego/function"
+	"github.com/avila-r/ego/maps"
+	"github.com/avila-r/ego/stream"
+)
+
+// GroupingBy groups elements by the key returned by classifier and collects
+// each group with the downstream collector. If downstream has no combiner,
+// neither has the result, so parallel streams collect it sequentially.
+func GroupingBy[T any, K comparable, A, D any](
+	classifier function.Function[T, K],
+	downstream stream.Collector[T, A, D],
+) stream.Collector[T, *maps.HashMap[K, A], *maps.HashMap[K, D]] {
+	supplier := downstream.Supplier()
+	accumulator := downstream.Accumulator()
+
+	var combiner function.BinaryOperator[*maps.HashMap[K, A]]
+	if groups := downstream.Combiner(); groups != nil {
+		combiner = function.NewBinaryOperator(func(a, b *maps.HashMap[K, A]) *maps.HashMap[K, A] {
+			for key, container := range b.All() {
+				a.Merge(key, container, func(existing, container A) (A, bool) {
+					return groups.Apply(existing, container), true
+				})
+			}
+			return a
+		})
+	}
+
+	return stream.NewCollector(
+		function.NewSupplier(maps.NewHashMap[K, A]),
+		function.NewBiConsumer(func(groups *maps.HashMap[K, A], element T) {
+			container := groups.ComputeIfAbsent(classifier.Apply(element), func(K) A {
+				return supplier.Get()
+			})
+			accumulator.Accept(container, element)
+		}),
+		combiner,
+		function.NewFunction(func(groups *maps.HashMap[K, A]) *maps.HashMap[K, D] {
+			result := maps.NewHashMap[K, D]()
+			for key, container := range groups.All() {
+				result.Put(key, downstream.Finisher().Apply(container))
+			}
+			return result
+		}),
+	)
+}
+
+// PartitioningBy splits elements into the true and false groups of predicate
+// and collects each group with the downstream collector. Both keys are
+// always present in the result, even when a group is empty.
+func PartitioningBy[T, A, D any](
+	predicate function.Predicate[T],
+	downstream stream.Collector[T, A, D],
+) stream.Collector[T, *maps.HashMap[bool, A], *maps.HashMap[bool, D]] {
+	grouping := GroupingBy(function.NewFunction(predicate.Test), downstream)
+
+	return stream.NewCollector(
+		function.NewSupplier(func() *maps.HashMap[bool, A] {
+			partitions := maps.NewHashMap[bool, A]()
+			partitions.Put(true, downstream.Supplier().Get())
+			partitions.Put(false, downstream.Supplier().Get())
+			return partitions
+		}),
+		grouping.Accumulator(),
+		grouping.Combiner(),
+		grouping.Finisher(),
+	)
+}
@@ -0,0 +1,104 @@
+package collectors
+
+import (
+	"strings"
+
+	"github.com/avila-r/ego/constraint"
+	"github.com/avila-r/ego/function"
+	"github.com/avila-r/ego/stream"
+)
+
+// Joining concatenates string elements, separated by delimiter
+func Joining(delimiter string) stream.Collector[string, *[]string, string] {
+	return JoiningWith(delimiter, "", "")
+}
+
+// JoiningWith concatenates string elements, separated by delimiter and
+// surrounded by prefix and suffix
+func JoiningWith(delimiter, prefix, suffix string) stream.Collector[string, *[]string, string] {
+	return stream.NewCollector(
+		function.NewSupplier(func() *[]string {
+			return &[]string{}
+		}),
+		function.NewBiConsumer(func(parts *[]string, element string) {
+			*parts = append(*parts, element)
+		}),
+		function.NewBinaryOperator(func(a, b *[]string) *[]string {
+			*a = append(*a, *b...)
+			return a
+		}),
+		function.NewFunction(func(parts *[]string) string {
+			return prefix + strings.Join(*parts, delimiter) + suffix
+		}),
+	)
+}
+
+// Counting counts the elements
+func Counting[T any]() stream.Collector[T, *int64, int64] {
+	return stream.NewCollector(
+		function.NewSupplier(func() *int64 {
+			return new(int64)
+		}),
+		function.NewBiConsumer(func(count *int64, _ T) {
+			*count++
+		}),
+		function.NewBinaryOperator(func(a, b *int64) *int64 {
+			*a += *b
+			return a
+		}),
+		function.NewFunction(func(count *int64) int64 {
+			return *count
+		}),
+	)
+}
+
+// SummingBy sums the values that mapper extracts from each element
+func SummingBy[T any, N constraint.Number](mapper function.Function[T, N]) stream.Collector[T, *N, N] {
+	return stream.NewCollector(
+		function.NewSupplier(func() *N {
+			return new(N)
+		}),
+		function.NewBiConsumer(func(sum *N, element T) {
+			*sum += mapper.Apply(element)
+		}),
+		function.NewBinaryOperator(func(a, b *N) *N {
+			*a += *b
+			return a
+		}),
+		function.NewFunction(func(sum *N) N {
+			return *sum
+		}),
+	)
+}
+
+// AveragingBy averages the values that mapper extracts from each element.
+// An empty stream averages to zero.
+func AveragingBy[T any, N constraint.Number](mapper function.Function[T, N]) stream.Collector[T, *stream.SummaryStatistics[N], float64] {
+	statistics := SummaryStatistics(mapper)
+
+	return stream.NewCollector(
+		statistics.Supplier(),
+		statistics.Accumulator(),
+		statistics.Combiner(),
+		function.NewFunction(func(s *stream.SummaryStatistics[N]) float64 {
+			return s.Average()
+		}),
+	)
+}
+
+// SummaryStatistics records count, sum, min, max, average and variance of the values
+// that mapper extracts from each element
+func SummaryStatistics[T any, N constraint.Number](mapper function.Function[T, N]) stream.Collector[T, *stream.SummaryStatistics[N], *stream.SummaryStatistics[N]] {
+	return stream.CollectorOf(
+		function.NewSupplier(func() *stream.SummaryStatistics[N] {
+			return &stream.SummaryStatistics[N]{}
+		}),
+		function.NewBiConsumer(func(s *stream.SummaryStatistics[N], element T) {
+			s.Accept(mapper.Apply(element))
+		}),
+		function.NewBinaryOperator(func(a, b *stream.SummaryStatistics[N]) *stream.SummaryStatistics[N] {
+			a.Combine(b)
+			return a
+		}),
+	)
+}
@@ -21,6 +21,10 @@ type (
 		Signed | Unsigned
 	}
 
+	Number interface {
+		Integer | Float
+	}
+
 	Arithmetic interface {
 		Integer | Float | Complex
 	}
@@ -0,0 +1,64 @@
+package function
+
+import (
+	"cmp"
+	"reflect"
+)
+
+// NaturalOrderOf resolves the natural order of T: its own Compare method,
+// or cmp.Compare for integers, floats, strings and types defined on them.
+// The order is looked up once, here; basic types then compare without
+// reflection. It reports false if T has no natural order.
+func NaturalOrderOf[T any]() (Comparator[T], bool) {
+	t := reflect.TypeFor[T]()
+
+	if t.Implements(reflect.TypeFor[ComparableMethod[T]]()) {
+		return NewComparator(func(a, b T) int {
+			return any(a).(ComparableMethod[T]).Compare(b)
+		}), true
+	}
+
+	switch t.Kind() {
+	case reflect.Int:
+		return underlying[T, int](reflect.Value.Int), true
+	case reflect.Int8:
+		return underlying[T, int8](reflect.Value.Int), true
+	case reflect.Int16:
+		return underlying[T, int16](reflect.Value.Int), true
+	case reflect.Int32:
+		return underlying[T, int32](reflect.Value.Int), true
+	case reflect.Int64:
+		return underlying[T, int64](reflect.Value.Int), true
+	case reflect.Uint:
+		return underlying[T, uint](reflect.Value.Uint), true
+	case reflect.Uint8:
+		return underlying[T, uint8](reflect.Value.Uint), true
+	case reflect.Uint16:
+		return underlying[T, uint16](reflect.Value.Uint), true
+	case reflect.Uint32:
+		return underlying[T, uint32](reflect.Value.Uint), true
+	case reflect.Uint64:
+		return underlying[T, uint64](reflect.Value.Uint), true
+	case reflect.Uintptr:
+		return underlying[T, uintptr](reflect.Value.Uint), true
+	case reflect.Float32:
+		return underlying[T, float32](reflect.Value.Float), true
+	case reflect.Float64:
+		return underlying[T, float64](reflect.Value.Float), true
+	case reflect.String:
+		return underlying[T, string](reflect.Value.String), true
+	}
+	return nil, false
+}
+
+// underlying compares values of T as U, which must be T's underlying type.
+// Types defined on U are read back through read, the widest reflect accessor
+// of their kind, which keeps the order of U.
+func underlying[T any, U cmp.Ordered, V cmp.Ordered](read func(reflect.Value) V) Comparator[T] {
+	if compare, ok := any(cmp.Compare[U]).(func(a, b T) int); ok {
+		return NewComparator(compare)
+	}
+	return NewComparator(func(a, b T) int {
+		return cmp.Compare(read(reflect.ValueOf(a)), read(reflect.ValueOf(b)))
+	})
+}
@@ -0,0 +1,8 @@
+// Package immutable provides persistent collections: every update returns a
+// new version that shares most of its structure with the previous one, so
+// old versions stay valid and can be handed to other goroutines without
+// copying or locking.
+//
+// Bulk loads go through transient builders, which update their private
+// nodes in place and hand back a persistent value when done.
+package immutable
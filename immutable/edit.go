@@ -0,0 +1,9 @@
+package immutable
+
+// edit identifies the transient that owns a node. Nodes whose edit matches
+// the active builder's may be updated in place; every other node is shared
+// and gets copied before a change. Persistent operations use a nil edit, so
+// they always copy.
+type edit struct {
+	_ byte
+}
@@ -0,0 +1,442 @@
+package immutable
+
+import (
+	"hash/maphash"
+	"iter"
+	mathbits "math/bits"
+	"slices"
+
+	"github.com/avila-r/ego/collection"
+	"github.com/avila-r/ego/iterator"
+)
+
+// seed is shared by every map so that versions derived from one another
+// place keys identically
+var seed = maphash.MakeSeed()
+
+// hashBits is the number of hash bits consumed per trie level
+const hashBits = 5
+
+type mapEntry[K comparable, V any] struct {
+	key   K
+	value V
+	hash  uint64
+}
+
+// mapNode is a compressed hash array mapped trie node. datamap marks the
+// slots that hold an entry inline and nodemap the ones that hold a child.
+// Once the 64 hash bits are exhausted, keys with equal hashes are kept in
+// collisions instead.
+type mapNode[K comparable, V any] struct {
+	edit       *edit
+	datamap    uint32
+	nodemap    uint32
+	entries    []mapEntry[K, V]
+	children   []*mapNode[K, V]
+	collisions []mapEntry[K, V]
+}
+
+func (n *mapNode[K, V]) own(e *edit) *mapNode[K, V] {
+	if e != nil && n.edit == e {
+		return n
+	}
+	return &mapNode[K, V]{
+		edit:       e,
+		datamap:    n.datamap,
+		nodemap:    n.nodemap,
+		entries:    slices.Clone(n.entries),
+		children:   slices.Clone(n.children),
+		collisions: slices.Clone(n.collisions),
+	}
+}
+
+// single returns the node's only entry if it holds nothing else
+func (n *mapNode[K, V]) single() (mapEntry[K, V], bool) {
+	switch {
+	case len(n.children) > 0:
+		return mapEntry[K, V]{}, false
+	case len(n.entries) == 1 && len(n.collisions) == 0:
+		return n.entries[0], true
+	case len(n.collisions) == 1 && len(n.entries) == 0:
+		return n.collisions[0], true
+	}
+	return mapEntry[K, V]{}, false
+}
+
+func (n *mapNode[K, V]) empty() bool {
+	return len(n.entries) == 0 && len(n.children) == 0 && len(n.collisions) == 0
+}
+
+func fragment(hash uint64, shift uint) uint32 {
+	return 1 << ((hash >> shift) & (1<<hashBits - 1))
+}
+
+func index(bitmap, bit uint32) int {
+	return mathbits.OnesCount32(bitmap & (bit - 1))
+}
+
+func (n *mapNode[K, V]) get(hash uint64, shift uint, key K) (V, bool) {
+	for n != nil {
+		if shift >= 64 {
+			for _, entry := range n.collisions {
+				if entry.key == key {
+					return entry.value, true
+				}
+			}
+			break
+		}
+
+		bit := fragment(hash, shift)
+		if n.datamap&bit != 0 {
+			if entry := n.entries[index(n.datamap, bit)]; entry.key == key {
+				return entry.value, true
+			}
+			break
+		}
+		if n.nodemap&bit == 0 {
+			break
+		}
+		n, shift = n.children[index(n.nodemap, bit)], shift+hashBits
+	}
+
+	var zero V
+	return zero, false
+}
+
+// put returns the updated node and whether the key was added rather than
+// replaced
+func (n *mapNode[K, V]) put(e *edit, shift uint, entry mapEntry[K, V]) (*mapNode[K, V], bool) {
+	if shift >= 64 {
+		owned := n.own(e)
+		for i, existing := range owned.collisions {
+			if existing.key == entry.key {
+				owned.collisions[i] = entry
+				return owned, false
+			}
+		}
+		owned.collisions = append(owned.collisions, entry)
+		return owned, true
+	}
+
+	bit := fragment(entry.hash, shift)
+	switch {
+	case n.datamap&bit != 0:
+		i := index(n.datamap, bit)
+		existing := n.entries[i]
+		owned := n.own(e)
+		if existing.key == entry.key {
+			owned.entries[i] = entry
+			return owned, false
+		}
+
+		child := pair(e, shift+hashBits, existing, entry)
+		owned.datamap ^= bit
+		owned.entries = slices.Delete(owned.entries, i, i+1)
+		owned.nodemap |= bit
+		owned.children = slices.Insert(owned.children, index(owned.nodemap, bit), child)
+		return owned, true
+
+	case n.nodemap&bit != 0:
+		i := index(n.nodemap, bit)
+		child, added := n.children[i].put(e, shift+hashBits, entry)
+		owned := n.own(e)
+		owned.children[i] = child
+		return owned, added
+
+	default:
+		owned := n.own(e)
+		owned.datamap |= bit
+		owned.entries = slices.Insert(owned.entries, index(owned.datamap, bit), entry)
+		return owned, true
+	}
+}
+
+// pair builds the smallest subtree holding two entries with distinct keys
+func pair[K comparable, V any](e *edit, shift uint, a, b mapEntry[K, V]) *mapNode[K, V] {
+	if shift >= 64 {
+		return &mapNode[K, V]{edit: e, collisions: []mapEntry[K, V]{a, b}}
+	}
+
+	bitA, bitB := fragment(a.hash, shift), fragment(b.hash, shift)
+	if bitA == bitB {
+		return &mapNode[K, V]{
+			edit:     e,
+			nodemap:  bitA,
+			children: []*mapNode[K, V]{pair(e, shift+hashBits, a, b)},
+		}
+	}
+
+	if bitB < bitA {
+		a, b = b, a
+	}
+	return &mapNode[K, V]{
+		edit:    e,
+		datamap: bitA | bitB,
+		entries: []mapEntry[K, V]{a, b},
+	}
+}
+
+// remove returns the updated node and whether the key was found. Children
+// left with a single entry are folded back into their parent, so equal maps
+// always have the same shape.
+func (n *mapNode[K, V]) remove(e *edit, hash uint64, shift uint, key K) (*mapNode[K, V], bool) {
+	if shift >= 64 {
+		for i, existing := range n.collisions {
+			if existing.key == key {
+				owned := n.own(e)
+				owned.collisions = slices.Delete(owned.collisions, i, i+1)
+				return owned, true
+			}
+		}
+		return n, false
+	}
+
+	bit := fragment(hash, shift)
+	switch {
+	case n.datamap&bit != 0:
+		i := index(n.datamap, bit)
+		if n.entries[i].key != key {
+			return n, false
+		}
+		owned := n.own(e)
+		owned.datamap ^= bit
+		owned.entries = slices.Delete(owned.entries, i, i+1)
+		return owned, true
+
+	case n.nodemap&bit != 0:
+		i := index(n.nodemap, bit)
+		child, removed := n.children[i].remove(e, hash, shift+hashBits, key)
+		if !removed {
+			return n, false
+		}
+
+		owned := n.own(e)
+		if entry, ok := child.single(); ok || child.empty() {
+			owned.nodemap ^= bit
+			owned.children = slices.Delete(owned.children, i, i+1)
+			if ok {
+				owned.datamap |= bit
+				owned.entries = slices.Insert(owned.entries, index(owned.datamap, bit), entry)
+			}
+			return owned, true
+		}
+		owned.children[i] = child
+		return owned, true
+	}
+	return n, false
+}
+
+func (n *mapNode[K, V]) each(yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	for _, entry := range n.entries {
+		if !yield(entry.key, entry.value) {
+			return false
+		}
+	}
+	for _, entry := range n.collisions {
+		if !yield(entry.key, entry.value) {
+			return false
+		}
+	}
+	for _, child := range n.children {
+		if !child.each(yield) {
+			return false
+		}
+	}
+	return true
+}
+
+// Map is a persistent hash map implemented as a hash array mapped trie. Get,
+// Put and Delete are O(log32 n) and every update shares all untouched
+// nodes with the previous version. Iteration order is unspecified. The zero
+// value is an empty map.
+type Map[K comparable, V any] struct {
+	root *mapNode[K, V]
+	size int
+}
+
+var _ collection.ReadOnlyMap[string, int] = (*Map[string, int])(nil)
+
+// EmptyMap returns an empty map
+func EmptyMap[K comparable, V any]() *Map[K, V] {
+	return &Map[K, V]{}
+}
+
+// MapFrom returns a map holding the entries of source
+func MapFrom[K comparable, V any](source map[K]V) *Map[K, V] {
+	builder := NewMapBuilder[K, V]()
+	for k, v := range source {
+		builder.Put(k, v)
+	}
+	return builder.Persistent()
+}
+
+func (m *Map[K, V]) Get(key K) (V, bool) {
+	return m.root.get(maphash.Comparable(seed, key), 0, key)
+}
+
+func (m *Map[K, V]) GetOrDefault(key K, fallback V) V {
+	if value, ok := m.Get(key); ok {
+		return value
+	}
+	return fallback
+}
+
+func (m *Map[K, V]) ContainsKey(key K) bool {
+	_, ok := m.Get(key)
+	return ok
+}
+
+func (m *Map[K, V]) Len() int {
+	return m.size
+}
+
+func (m *Map[K, V]) IsEmpty() bool {
+	return m.size == 0
+}
+
+// Put returns a copy of the map with key set to value
+func (m *Map[K, V]) Put(key K, value V) *Map[K, V] {
+	updated := *m
+	updated.put(nil, key, value)
+	return &updated
+}
+
+// Delete returns a copy of the map without key
+func (m *Map[K, V]) Delete(key K) *Map[K, V] {
+	updated := *m
+	if !updated.delete(nil, key) {
+		return m
+	}
+	return &updated
+}
+
+// Transient returns a builder that starts from this map. The map itself is
+// never modified.
+func (m *Map[K, V]) Transient() *MapBuilder[K, V] {
+	return &MapBuilder[K, V]{m: *m, edit: &edit{}}
+}
+
+func (m *Map[K, V]) put(e *edit, key K, value V) {
+	root := m.root
+	if root == nil {
+		root = &mapNode[K, V]{}
+	}
+	root, added := root.put(e, 0, mapEntry[K, V]{key: key, value: value, hash: maphash.Comparable(seed, key)})
+	m.root = root
+	if added {
+		m.size++
+	}
+}
+
+func (m *Map[K, V]) delete(e *edit, key K) bool {
+	if m.root == nil {
+		return false
+	}
+	root, removed := m.root.remove(e, maphash.Comparable(seed, key), 0, key)
+	if removed {
+		m.root = root
+		m.size--
+	}
+	return removed
+}
+
+func (m *Map[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.root.each(yield)
+	}
+}
+
+func (m *Map[K, V]) ForEach(action func(K, V)) {
+	for k, v := range m.All() {
+		action(k, v)
+	}
+}
+
+func (m *Map[K, V]) KeySlice() []K {
+	keys := make([]K, 0, m.size)
+	for k := range m.All() {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func (m *Map[K, V]) ValueSlice() []V {
+	values := make([]V, 0, m.size)
+	for _, v := range m.All() {
+		values = append(values, v)
+	}
+	return values
+}
+
+func (m *Map[K, V]) ToSlice() []collection.Entry[K, V] {
+	entries := make([]collection.Entry[K, V], 0, m.size)
+	for k, v := range m.All() {
+		entries = append(entries, collection.Entry[K, V]{Key: k, Value: v})
+	}
+	return entries
+}
+
+func (m *Map[K, V]) Elements() map[K]V {
+	elements := make(map[K]V, m.size)
+	for k, v := range m.All() {
+		elements[k] = v
+	}
+	return elements
+}
+
+func (m *Map[K, V]) Iterator() iterator.Iterator[collection.Entry[K, V]] {
+	return iterator.FromSeq(entries(m.All()))
+}
+
+// MapBuilder is a transient map for bulk updates. It changes the nodes it
+// created in place instead of copying them on every update. A builder is
+// not safe for concurrent use.
+type MapBuilder[K comparable, V any] struct {
+	m    Map[K, V]
+	edit *edit
+}
+
+// NewMapBuilder returns a builder that starts from an empty map
+func NewMapBuilder[K comparable, V any]() *MapBuilder[K, V] {
+	return EmptyMap[K, V]().Transient()
+}
+
+func (b *MapBuilder[K, V]) Put(key K, value V) *MapBuilder[K, V] {
+	b.m.put(b.edit, key, value)
+	return b
+}
+
+// Delete removes key, reporting whether it was present
+func (b *MapBuilder[K, V]) Delete(key K) bool {
+	return b.m.delete(b.edit, key)
+}
+
+func (b *MapBuilder[K, V]) Get(key K) (V, bool) {
+	return b.m.Get(key)
+}
+
+func (b *MapBuilder[K, V]) Len() int {
+	return b.m.size
+}
+
+// Persistent returns the built map. The builder can keep being used
+// afterwards without affecting the returned map.
+func (b *MapBuilder[K, V]) Persistent() *Map[K, V] {
+	built := b.m
+	b.edit = &edit{}
+	return &built
+}
+
+// entries adapts a key/value sequence into a sequence of Entry objects
+func entries[K comparable, V any](seq iter.Seq2[K, V]) iter.Seq[collection.Entry[K, V]] {
+	return func(yield func(collection.Entry[K, V]) bool) {
+		for k, v := range seq {
+			if !yield(collection.Entry[K, V]{Key: k, Value: v}) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package immutable_test
+
+import (
+	"maps"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/avila-r/ego/collection"
+	"github.com/avila-r/ego/immutable"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Map_PutGetDelete(t *testing.T) {
+	m := immutable.EmptyMap[string, int]().
+		Put("a", 1).
+		Put("b", 2).
+		Put("a", 10)
+
+	assert.Equal(t, 2, m.Len())
+	assert.Equal(t, 10, m.GetOrDefault("a", 0))
+	assert.Equal(t, -1, m.GetOrDefault("z", -1))
+	assert.True(t, m.ContainsKey("b"))
+
+	deleted := m.Delete("a")
+	assert.Equal(t, 1, deleted.Len())
+	assert.False(t, deleted.ContainsKey("a"))
+	assert.Same(t, deleted, deleted.Delete("missing"))
+}
+
+func Test_Map_ZeroValue(t *testing.T) {
+	var m immutable.Map[int, int]
+
+	assert.True(t, m.IsEmpty())
+	assert.False(t, m.ContainsKey(1))
+	assert.Equal(t, 1, m.Put(1, 1).Len())
+	assert.True(t, m.Delete(1).IsEmpty())
+}
+
+func Test_Map_IsPersistent(t *testing.T) {
+	base := immutable.MapFrom(map[int]string{1: "one", 2: "two"})
+
+	updated := base.Put(3, "three").Put(1, "uno").Delete(2)
+
+	assert.Equal(t, map[int]string{1: "one", 2: "two"}, base.Elements())
+	assert.Equal(t, map[int]string{1: "uno", 3: "three"}, updated.Elements())
+}
+
+func Test_Map_MatchesBuiltin(t *testing.T) {
+	random := rand.New(rand.NewSource(3))
+	model := map[int]int{}
+	m := immutable.EmptyMap[int, int]()
+	versions := map[*immutable.Map[int, int]]map[int]int{}
+
+	for i := range 50_000 {
+		key := random.Intn(5_000)
+		if random.Intn(3) == 0 {
+			m = m.Delete(key)
+			delete(model, key)
+		} else {
+			m = m.Put(key, i)
+			model[key] = i
+		}
+		if i%5000 == 0 {
+			versions[m] = maps.Clone(model)
+		}
+	}
+
+	assert.Equal(t, len(model), m.Len())
+	assert.Equal(t, model, m.Elements())
+	for version, expected := range versions {
+		assert.Equal(t, expected, version.Elements())
+	}
+}
+
+func Test_Map_DeleteAll(t *testing.T) {
+	m := immutable.MapFrom(map[int]bool{})
+	for i := range 1000 {
+		m = m.Put(i, true)
+	}
+	for i := range 1000 {
+		m = m.Delete(i)
+	}
+
+	assert.True(t, m.IsEmpty())
+	assert.Empty(t, m.KeySlice())
+}
+
+func Test_MapBuilder(t *testing.T) {
+	base := immutable.MapFrom(map[string]int{"a": 1})
+
+	builder := base.Transient()
+	for i := range 100 {
+		builder.Put(string(rune('b'+i)), i)
+	}
+	assert.True(t, builder.Delete("a"))
+	assert.False(t, builder.Delete("a"))
+
+	built := builder.Persistent()
+	builder.Put("late", 0)
+
+	assert.Equal(t, map[string]int{"a": 1}, base.Elements())
+	assert.Equal(t, 100, built.Len())
+	assert.False(t, built.ContainsKey("late"))
+	assert.Equal(t, 101, builder.Len())
+}
+
+func Test_Map_Iteration(t *testing.T) {
+	m := immutable.MapFrom(map[int]int{1: 10, 2: 20, 3: 30})
+
+	keys := m.KeySlice()
+	slices.Sort(keys)
+	assert.Equal(t, []int{1, 2, 3}, keys)
+
+	values := m.ValueSlice()
+	slices.Sort(values)
+	assert.Equal(t, []int{10, 20, 30}, values)
+
+	assert.Len(t, m.Iterator().Collect(), 3)
+	assert.ElementsMatch(t, []collection.Entry[int, int]{{Key: 1, Value: 10}, {Key: 2, Value: 20}, {Key: 3, Value: 30}}, m.ToSlice())
+
+	for range m.All() {
+		break
+	}
+}
+
+var _ collection.ReadOnlyMap[int, int] = immutable.EmptyMap[int, int]()
+
+func Test_MapBuilder_MatchesBuiltin(t *testing.T) {
+	random := rand.New(rand.NewSource(7))
+	model := map[int]int{}
+	builder := immutable.NewMapBuilder[int, int]()
+	snapshots := map[*immutable.Map[int, int]]map[int]int{}
+
+	for i := range 50_000 {
+		key := random.Intn(5_000)
+		if random.Intn(3) == 0 {
+			builder.Delete(key)
+			delete(model, key)
+		} else {
+			builder.Put(key, i)
+			model[key] = i
+		}
+		if i%4999 == 0 {
+			snapshots[builder.Persistent()] = maps.Clone(model)
+		}
+	}
+
+	assert.Equal(t, model, builder.Persistent().Elements())
+	for snapshot, expected := range snapshots {
+		assert.Equal(t, expected, snapshot.Elements())
+	}
+}
@@ -0,0 +1,446 @@
+package immutable
+
+import (
+	"cmp"
+	"hash/maphash"
+	"iter"
+
+	"github.com/avila-r/ego/collection"
+	"github.com/avila-r/ego/constraint"
+	"github.com/avila-r/ego/function"
+	"github.com/avila-r/ego/iterator"
+)
+
+// sortedNode is a treap node: ordered by key, heap-ordered by priority
+type sortedNode[K comparable, V any] struct {
+	edit     *edit
+	key      K
+	value    V
+	priority uint64
+	left     *sortedNode[K, V]
+	right    *sortedNode[K, V]
+}
+
+func (n *sortedNode[K, V]) own(e *edit) *sortedNode[K, V] {
+	if e != nil && n.edit == e {
+		return n
+	}
+	owned := *n
+	owned.edit = e
+	return &owned
+}
+
+// SortedMap is a persistent map sorted by key, backed by a treap whose
+// priorities are derived from key hashes. Since the shape depends only on
+// the keys, lookups and updates are O(log n) in expectation regardless of
+// insertion order, and updates copy just the path to the changed key.
+type SortedMap[K comparable, V any] struct {
+	root       *sortedNode[K, V]
+	size       int
+	comparator function.Comparator[K]
+}
+
+var _ collection.ReadOnlyMap[string, int] = (*SortedMap[string, int])(nil)
+
+// EmptySortedMap returns an empty map ordered by comparator
+func EmptySortedMap[K comparable, V any](comparator function.Comparator[K]) *SortedMap[K, V] {
+	return &SortedMap[K, V]{comparator: comparator}
+}
+
+// EmptyOrderedSortedMap returns an empty map ordered by the natural order of
+// K
+func EmptyOrderedSortedMap[K constraint.Ordered, V any]() *SortedMap[K, V] {
+	return EmptySortedMap[K, V](function.NewComparator(cmp.Compare[K]))
+}
+
+func (m *SortedMap[K, V]) compare(a, b K) int {
+	return m.comparator.Compare(a, b)
+}
+
+func (m *SortedMap[K, V]) node(key K) *sortedNode[K, V] {
+	for current := m.root; current != nil; {
+		switch c := m.compare(key, current.key); {
+		case c < 0:
+			current = current.left
+		case c > 0:
+			current = current.right
+		default:
+			return current
+		}
+	}
+	return nil
+}
+
+func (m *SortedMap[K, V]) Get(key K) (V, bool) {
+	if node := m.node(key); node != nil {
+		return node.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+func (m *SortedMap[K, V]) GetOrDefault(key K, fallback V) V {
+	if value, ok := m.Get(key); ok {
+		return value
+	}
+	return fallback
+}
+
+func (m *SortedMap[K, V]) ContainsKey(key K) bool {
+	return m.node(key) != nil
+}
+
+func (m *SortedMap[K, V]) Len() int {
+	return m.size
+}
+
+func (m *SortedMap[K, V]) IsEmpty() bool {
+	return m.size == 0
+}
+
+// Put returns a copy of the map with key set to value
+func (m *SortedMap[K, V]) Put(key K, value V) *SortedMap[K, V] {
+	updated := *m
+	updated.put(nil, key, value)
+	return &updated
+}
+
+// Delete returns a copy of the map without key
+func (m *SortedMap[K, V]) Delete(key K) *SortedMap[K, V] {
+	updated := *m
+	if !updated.delete(nil, key) {
+		return m
+	}
+	return &updated
+}
+
+// Transient returns a builder that starts from this map. The map itself is
+// never modified.
+func (m *SortedMap[K, V]) Transient() *SortedMapBuilder[K, V] {
+	return &SortedMapBuilder[K, V]{m: *m, edit: &edit{}}
+}
+
+func (m *SortedMap[K, V]) put(e *edit, key K, value V) {
+	root, added := m.insert(e, m.root, key, value)
+	m.root = root
+	if added {
+		m.size++
+	}
+}
+
+func (m *SortedMap[K, V]) insert(e *edit, h *sortedNode[K, V], key K, value V) (*sortedNode[K, V], bool) {
+	if h == nil {
+		return &sortedNode[K, V]{
+			edit:     e,
+			key:      key,
+			value:    value,
+			priority: maphash.Comparable(seed, key),
+		}, true
+	}
+
+	c := m.compare(key, h.key)
+	if c == 0 {
+		owned := h.own(e)
+		owned.value = value
+		return owned, false
+	}
+
+	// The returned child is either fresh or owned by e, so rotating it in
+	// place is safe
+	if c < 0 {
+		left, added := m.insert(e, h.left, key, value)
+		owned := h.own(e)
+		owned.left = left
+		if left.priority > owned.priority {
+			owned.left, left.right = left.right, owned
+			return left, added
+		}
+		return owned, added
+	}
+
+	right, added := m.insert(e, h.right, key, value)
+	owned := h.own(e)
+	owned.right = right
+	if right.priority > owned.priority {
+		owned.right, right.left = right.left, owned
+		return right, added
+	}
+	return owned, added
+}
+
+func (m *SortedMap[K, V]) delete(e *edit, key K) bool {
+	root, removed := m.remove(e, m.root, key)
+	if removed {
+		m.root = root
+		m.size--
+	}
+	return removed
+}
+
+func (m *SortedMap[K, V]) remove(e *edit, h *sortedNode[K, V], key K) (*sortedNode[K, V], bool) {
+	if h == nil {
+		return nil, false
+	}
+
+	switch c := m.compare(key, h.key); {
+	case c < 0:
+		left, removed := m.remove(e, h.left, key)
+		if !removed {
+			return h, false
+		}
+		owned := h.own(e)
+		owned.left = left
+		return owned, true
+	case c > 0:
+		right, removed := m.remove(e, h.right, key)
+		if !removed {
+			return h, false
+		}
+		owned := h.own(e)
+		owned.right = right
+		return owned, true
+	default:
+		return join(e, h.left, h.right), true
+	}
+}
+
+// join merges two treaps where every key in a precedes every key in b
+func join[K comparable, V any](e *edit, a, b *sortedNode[K, V]) *sortedNode[K, V] {
+	switch {
+	case a == nil:
+		return b
+	case b == nil:
+		return a
+	case a.priority > b.priority:
+		owned := a.own(e)
+		owned.right = join(e, a.right, b)
+		return owned
+	default:
+		owned := b.own(e)
+		owned.left = join(e, a, b.left)
+		return owned
+	}
+}
+
+// FirstKey returns the smallest key, if the map isn't empty
+func (m *SortedMap[K, V]) FirstKey() (K, bool) {
+	first, ok := m.first()
+	return first.Key, ok
+}
+
+// LastKey returns the largest key, if the map isn't empty
+func (m *SortedMap[K, V]) LastKey() (K, bool) {
+	last, ok := m.last()
+	return last.Key, ok
+}
+
+func (m *SortedMap[K, V]) first() (collection.Entry[K, V], bool) {
+	if m.root == nil {
+		return collection.Entry[K, V]{}, false
+	}
+	current := m.root
+	for current.left != nil {
+		current = current.left
+	}
+	return collection.Entry[K, V]{Key: current.key, Value: current.value}, true
+}
+
+func (m *SortedMap[K, V]) last() (collection.Entry[K, V], bool) {
+	if m.root == nil {
+		return collection.Entry[K, V]{}, false
+	}
+	current := m.root
+	for current.right != nil {
+		current = current.right
+	}
+	return collection.Entry[K, V]{Key: current.key, Value: current.value}, true
+}
+
+// Floor returns the entry with the largest key less than or equal to key
+func (m *SortedMap[K, V]) Floor(key K) (collection.Entry[K, V], bool) {
+	return m.closest(key, true, true)
+}
+
+// Ceiling returns the entry with the smallest key greater than or equal to
+// key
+func (m *SortedMap[K, V]) Ceiling(key K) (collection.Entry[K, V], bool) {
+	return m.closest(key, false, true)
+}
+
+// Lower returns the entry with the largest key strictly less than key
+func (m *SortedMap[K, V]) Lower(key K) (collection.Entry[K, V], bool) {
+	return m.closest(key, true, false)
+}
+
+// Higher returns the entry with the smallest key strictly greater than key
+func (m *SortedMap[K, V]) Higher(key K) (collection.Entry[K, V], bool) {
+	return m.closest(key, false, false)
+}
+
+func (m *SortedMap[K, V]) closest(key K, below, inclusive bool) (collection.Entry[K, V], bool) {
+	var best *sortedNode[K, V]
+	for current := m.root; current != nil; {
+		c := m.compare(key, current.key)
+		if c == 0 && inclusive {
+			best = current
+			break
+		}
+		if below {
+			if c > 0 {
+				best, current = current, current.right
+			} else {
+				current = current.left
+			}
+		} else {
+			if c < 0 {
+				best, current = current, current.left
+			} else {
+				current = current.right
+			}
+		}
+	}
+
+	if best == nil {
+		return collection.Entry[K, V]{}, false
+	}
+	return collection.Entry[K, V]{Key: best.key, Value: best.value}, true
+}
+
+// All returns a sequence over the entries in ascending key order
+func (m *SortedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.ascend(m.root, nil, nil, yield)
+	}
+}
+
+// Backward returns a sequence over the entries in descending key order
+func (m *SortedMap[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.descend(m.root, yield)
+	}
+}
+
+// Range returns a sequence over the entries whose keys fall in [from, to),
+// in ascending order
+func (m *SortedMap[K, V]) Range(from, to K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.ascend(m.root, &from, &to, yield)
+	}
+}
+
+// ascend walks the keys in [from, to); a nil bound is open
+func (m *SortedMap[K, V]) ascend(h *sortedNode[K, V], from, to *K, yield func(K, V) bool) bool {
+	if h == nil {
+		return true
+	}
+
+	aboveFrom := from == nil || m.compare(h.key, *from) >= 0
+	belowTo := to == nil || m.compare(h.key, *to) < 0
+
+	if aboveFrom && !m.ascend(h.left, from, to, yield) {
+		return false
+	}
+	if aboveFrom && belowTo && !yield(h.key, h.value) {
+		return false
+	}
+	if belowTo && !m.ascend(h.right, from, to, yield) {
+		return false
+	}
+	return true
+}
+
+func (m *SortedMap[K, V]) descend(h *sortedNode[K, V], yield func(K, V) bool) bool {
+	if h == nil {
+		return true
+	}
+	return m.descend(h.right, yield) && yield(h.key, h.value) && m.descend(h.left, yield)
+}
+
+func (m *SortedMap[K, V]) ForEach(action func(K, V)) {
+	for k, v := range m.All() {
+		action(k, v)
+	}
+}
+
+func (m *SortedMap[K, V]) KeySlice() []K {
+	keys := make([]K, 0, m.size)
+	for k := range m.All() {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func (m *SortedMap[K, V]) ValueSlice() []V {
+	values := make([]V, 0, m.size)
+	for _, v := range m.All() {
+		values = append(values, v)
+	}
+	return values
+}
+
+func (m *SortedMap[K, V]) ToSlice() []collection.Entry[K, V] {
+	entries := make([]collection.Entry[K, V], 0, m.size)
+	for k, v := range m.All() {
+		entries = append(entries, collection.Entry[K, V]{Key: k, Value: v})
+	}
+	return entries
+}
+
+func (m *SortedMap[K, V]) Elements() map[K]V {
+	elements := make(map[K]V, m.size)
+	for k, v := range m.All() {
+		elements[k] = v
+	}
+	return elements
+}
+
+func (m *SortedMap[K, V]) Iterator() iterator.Iterator[collection.Entry[K, V]] {
+	return iterator.Iterate(m.first, func(e collection.Entry[K, V]) (collection.Entry[K, V], bool) {
+		return m.Higher(e.Key)
+	})
+}
+
+func (m *SortedMap[K, V]) DescendingIterator() iterator.Iterator[collection.Entry[K, V]] {
+	return iterator.Iterate(m.last, func(e collection.Entry[K, V]) (collection.Entry[K, V], bool) {
+		return m.Lower(e.Key)
+	})
+}
+
+// SortedMapBuilder is a transient sorted map for bulk updates. It is not
+// safe for concurrent use.
+type SortedMapBuilder[K comparable, V any] struct {
+	m    SortedMap[K, V]
+	edit *edit
+}
+
+// NewSortedMapBuilder returns a builder that starts from an empty map
+// ordered by comparator
+func NewSortedMapBuilder[K comparable, V any](comparator function.Comparator[K]) *SortedMapBuilder[K, V] {
+	return EmptySortedMap[K, V](comparator).Transient()
+}
+
+func (b *SortedMapBuilder[K, V]) Put(key K, value V) *SortedMapBuilder[K, V] {
+	b.m.put(b.edit, key, value)
+	return b
+}
+
+// Delete removes key, reporting whether it was present
+func (b *SortedMapBuilder[K, V]) Delete(key K) bool {
+	return b.m.delete(b.edit, key)
+}
+
+func (b *SortedMapBuilder[K, V]) Get(key K) (V, bool) {
+	return b.m.Get(key)
+}
+
+func (b *SortedMapBuilder[K, V]) Len() int {
+	return b.m.size
+}
+
+// Persistent returns the built map. The builder can keep being used
+// afterwards without affecting the returned map.
+func (b *SortedMapBuilder[K, V]) Persistent() *SortedMap[K, V] {
+	built := b.m
+	b.edit = &edit{}
+	return &built
+}
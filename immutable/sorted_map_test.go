@@ -0,0 +1,179 @@
+package immutable_test
+
+import (
+	"maps"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/avila-r/ego/collection"
+	"github.com/avila-r/ego/function"
+	"github.com/avila-r/ego/immutable"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SortedMap_Order(t *testing.T) {
+	m := immutable.EmptyOrderedSortedMap[int, string]()
+	for _, k := range []int{5, 1, 9, 3, 7} {
+		m = m.Put(k, "")
+	}
+
+	assert.Equal(t, []int{1, 3, 5, 7, 9}, m.KeySlice())
+
+	var backward []int
+	for k := range m.Backward() {
+		backward = append(backward, k)
+	}
+	assert.Equal(t, []int{9, 7, 5, 3, 1}, backward)
+
+	first, _ := m.FirstKey()
+	last, _ := m.LastKey()
+	assert.Equal(t, 1, first)
+	assert.Equal(t, 9, last)
+}
+
+func Test_SortedMap_Comparator(t *testing.T) {
+	descending := function.NewComparator(func(a, b string) int {
+		return -len(a) + len(b)
+	})
+	m := immutable.EmptySortedMap[string, int](descending).
+		Put("a", 1).
+		Put("ccc", 3).
+		Put("bb", 2)
+
+	assert.Equal(t, []string{"ccc", "bb", "a"}, m.KeySlice())
+}
+
+func Test_SortedMap_Navigation(t *testing.T) {
+	m := immutable.EmptyOrderedSortedMap[int, int]()
+	for _, k := range []int{10, 20, 30} {
+		m = m.Put(k, k*10)
+	}
+
+	type Case struct {
+		name     string
+		find     func(int) (collection.Entry[int, int], bool)
+		key      int
+		expected int
+		found    bool
+	}
+
+	cases := []Case{
+		{"floor exact", m.Floor, 20, 20, true},
+		{"floor between", m.Floor, 25, 20, true},
+		{"floor below all", m.Floor, 5, 0, false},
+		{"ceiling between", m.Ceiling, 15, 20, true},
+		{"ceiling above all", m.Ceiling, 35, 0, false},
+		{"lower exact", m.Lower, 20, 10, true},
+		{"higher exact", m.Higher, 20, 30, true},
+		{"higher last", m.Higher, 30, 0, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			entry, found := c.find(c.key)
+			assert.Equal(t, c.found, found)
+			assert.Equal(t, c.expected, entry.Key)
+			if found {
+				assert.Equal(t, c.expected*10, entry.Value)
+			}
+		})
+	}
+}
+
+func Test_SortedMap_Range(t *testing.T) {
+	m := immutable.EmptyOrderedSortedMap[int, int]()
+	for i := range 20 {
+		m = m.Put(i, i)
+	}
+
+	var keys []int
+	for k := range m.Range(5, 9) {
+		keys = append(keys, k)
+	}
+	assert.Equal(t, []int{5, 6, 7, 8}, keys)
+
+	for k := range m.Range(5, 9) {
+		if k == 6 {
+			break
+		}
+	}
+}
+
+func Test_SortedMap_MatchesBuiltin(t *testing.T) {
+	random := rand.New(rand.NewSource(11))
+	model := map[int]int{}
+	m := immutable.EmptyOrderedSortedMap[int, int]()
+	versions := map[*immutable.SortedMap[int, int]]map[int]int{}
+
+	for i := range 30_000 {
+		key := random.Intn(3_000)
+		if random.Intn(3) == 0 {
+			m = m.Delete(key)
+			delete(model, key)
+		} else {
+			m = m.Put(key, i)
+			model[key] = i
+		}
+		if i%3000 == 0 {
+			versions[m] = maps.Clone(model)
+		}
+	}
+
+	assert.Equal(t, slices.Sorted(maps.Keys(model)), m.KeySlice())
+	assert.Equal(t, model, m.Elements())
+	for version, expected := range versions {
+		assert.Equal(t, expected, version.Elements())
+	}
+}
+
+func Test_SortedMapBuilder(t *testing.T) {
+	base := immutable.EmptyOrderedSortedMap[int, int]().Put(0, 0)
+
+	builder := base.Transient()
+	for i := 100; i > 0; i-- {
+		builder.Put(i, i)
+	}
+	assert.True(t, builder.Delete(50))
+	assert.False(t, builder.Delete(50))
+
+	built := builder.Persistent()
+	builder.Put(50, 50)
+	builder.Delete(1)
+
+	assert.Equal(t, []int{0}, base.KeySlice())
+	assert.Equal(t, 100, built.Len())
+	assert.False(t, built.ContainsKey(50))
+	assert.True(t, built.ContainsKey(1))
+	assert.Equal(t, 100, builder.Len())
+
+	keys := built.KeySlice()
+	assert.True(t, slices.IsSorted(keys))
+}
+
+func Test_SortedMapBuilder_MatchesBuiltin(t *testing.T) {
+	random := rand.New(rand.NewSource(13))
+	model := map[int]int{}
+	builder := immutable.NewSortedMapBuilder[int, int](function.NewComparator(func(a, b int) int { return a - b }))
+	snapshots := map[*immutable.SortedMap[int, int]]map[int]int{}
+
+	for i := range 30_000 {
+		key := random.Intn(3_000)
+		if random.Intn(3) == 0 {
+			builder.Delete(key)
+			delete(model, key)
+		} else {
+			builder.Put(key, i)
+			model[key] = i
+		}
+		if i%2999 == 0 {
+			snapshots[builder.Persistent()] = maps.Clone(model)
+		}
+	}
+
+	assert.Equal(t, model, builder.Persistent().Elements())
+	for snapshot, expected := range snapshots {
+		assert.Equal(t, expected, snapshot.Elements())
+		assert.True(t, slices.IsSorted(snapshot.KeySlice()))
+	}
+}
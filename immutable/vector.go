@@ -0,0 +1,396 @@
+package immutable
+
+import (
+	"iter"
+
+	"github.com/avila-r/ego/collection"
+	"github.com/avila-r/ego/iterator"
+	"github.com/avila-r/ego/stream"
+)
+
+const (
+	bits  = 5
+	width = 1 << bits
+	mask  = width - 1
+)
+
+// vectorNode is a trie node: leaves hold values, branches hold children
+type vectorNode[T any] struct {
+	edit     *edit
+	children []*vectorNode[T]
+	values   []T
+}
+
+func (n *vectorNode[T]) own(e *edit) *vectorNode[T] {
+	if e != nil && n.edit == e {
+		return n
+	}
+	return &vectorNode[T]{
+		edit:     e,
+		children: clone(n.children),
+		values:   clone(n.values),
+	}
+}
+
+func clone[S ~[]E, E any](s S) S {
+	if s == nil {
+		return nil
+	}
+	cloned := make(S, len(s), width)
+	copy(cloned, s)
+	return cloned
+}
+
+// Vector is a persistent indexed sequence backed by a 32-way trie with a
+// tail buffer. Get and Set are O(log32 n), which is effectively constant,
+// and Append is amortized O(1). The zero value is an empty vector.
+type Vector[T any] struct {
+	size  int
+	shift uint
+	root  *vectorNode[T]
+	tail  []T
+}
+
+var _ collection.ReadOnlyList[int] = (*Vector[int])(nil)
+
+// EmptyVector returns an empty vector
+func EmptyVector[T any]() *Vector[T] {
+	return &Vector[T]{}
+}
+
+// VectorOf returns a vector holding values
+func VectorOf[T any](values ...T) *Vector[T] {
+	builder := NewVectorBuilder[T]()
+	builder.Append(values...)
+	return builder.Persistent()
+}
+
+func (v *Vector[T]) tailOffset() int {
+	if v.size < width {
+		return 0
+	}
+	return ((v.size - 1) >> bits) << bits
+}
+
+// leaf returns the values array holding index, which must be in range
+func (v *Vector[T]) leaf(index int) []T {
+	if index >= v.tailOffset() {
+		return v.tail
+	}
+	node := v.root
+	for level := v.shift; level > 0; level -= bits {
+		node = node.children[(index>>level)&mask]
+	}
+	return node.values
+}
+
+func (v *Vector[T]) Get(index int) (T, bool) {
+	if index < 0 || index >= v.size {
+		var zero T
+		return zero, false
+	}
+	return v.leaf(index)[index&mask], true
+}
+
+// Last returns the last element, if the vector isn't empty
+func (v *Vector[T]) Last() (T, bool) {
+	return v.Get(v.size - 1)
+}
+
+func (v *Vector[T]) Size() int {
+	return v.size
+}
+
+func (v *Vector[T]) IsEmpty() bool {
+	return v.size == 0
+}
+
+// Set returns a copy of the vector with the element at index replaced. It
+// reports false and returns the vector unchanged if index is out of range.
+func (v *Vector[T]) Set(index int, value T) (*Vector[T], bool) {
+	if index < 0 || index >= v.size {
+		return v, false
+	}
+	updated := *v
+	updated.set(nil, index, value)
+	return &updated, true
+}
+
+// Append returns a copy of the vector with values added at the end
+func (v *Vector[T]) Append(values ...T) *Vector[T] {
+	if len(values) > width {
+		builder := v.Transient()
+		builder.Append(values...)
+		return builder.Persistent()
+	}
+	updated := *v
+	for _, value := range values {
+		updated.append(nil, value)
+	}
+	return &updated
+}
+
+// Pop returns a copy of the vector without its last element. It reports
+// false if the vector is empty.
+func (v *Vector[T]) Pop() (*Vector[T], bool) {
+	if v.size == 0 {
+		return v, false
+	}
+	updated := *v
+	updated.pop(nil)
+	return &updated, true
+}
+
+// Transient returns a builder that starts from this vector. The vector
+// itself is never modified.
+func (v *Vector[T]) Transient() *VectorBuilder[T] {
+	return &VectorBuilder[T]{vector: *v, edit: &edit{}}
+}
+
+func (v *Vector[T]) Elements() []T {
+	elements := make([]T, 0, v.size)
+	for value := range v.All() {
+		elements = append(elements, value)
+	}
+	return elements
+}
+
+func (v *Vector[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for start := 0; start < v.size; start += width {
+			leaf := v.leaf(start)
+			for i := 0; i < len(leaf) && start+i < v.size; i++ {
+				if !yield(leaf[i]) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (v *Vector[T]) ForEach(action func(T)) {
+	for value := range v.All() {
+		action(value)
+	}
+}
+
+func (v *Vector[T]) Stream() stream.Stream[T] {
+	return stream.FromSeq(v.All())
+}
+
+func (v *Vector[T]) Iterator() iterator.Iterator[T] {
+	indexes := iterator.Iterate(
+		func() (int, bool) { return 0, v.size > 0 },
+		func(i int) (int, bool) { return i + 1, i+1 < v.size },
+	)
+	return iterator.Map(indexes, func(i int) T {
+		value, _ := v.Get(i)
+		return value
+	})
+}
+
+// The mutators below update v itself. Persistent operations call them on a
+// fresh copy of the Vector header with a nil edit, so every node they touch
+// is copied; builders pass their own edit and reuse the nodes they own.
+
+func (v *Vector[T]) set(e *edit, index int, value T) {
+	if index >= v.tailOffset() {
+		if e == nil {
+			v.tail = clone(v.tail)
+		}
+		v.tail[index&mask] = value
+		return
+	}
+	v.root = v.assoc(e, v.shift, v.root, index, value)
+}
+
+func (v *Vector[T]) assoc(e *edit, level uint, node *vectorNode[T], index int, value T) *vectorNode[T] {
+	owned := node.own(e)
+	if level == 0 {
+		owned.values[index&mask] = value
+		return owned
+	}
+	i := (index >> level) & mask
+	owned.children[i] = v.assoc(e, level-bits, node.children[i], index, value)
+	return owned
+}
+
+func (v *Vector[T]) append(e *edit, value T) {
+	if v.size-v.tailOffset() < width {
+		if e == nil {
+			v.tail = clone(v.tail)
+		}
+		if v.tail == nil {
+			v.tail = make([]T, 0, width)
+		}
+		v.tail = append(v.tail, value)
+		v.size++
+		return
+	}
+
+	tail := &vectorNode[T]{edit: e, values: v.tail}
+	switch {
+	case v.root == nil:
+		v.root = &vectorNode[T]{edit: e, children: []*vectorNode[T]{tail}}
+		v.shift = bits
+	case v.size>>bits > 1<<v.shift:
+		v.root = &vectorNode[T]{edit: e, children: []*vectorNode[T]{v.root, newPath(e, v.shift, tail)}}
+		v.shift += bits
+	default:
+		v.root = v.pushTail(e, v.shift, v.root, tail)
+	}
+
+	v.tail = make([]T, 1, width)
+	v.tail[0] = value
+	v.size++
+}
+
+func (v *Vector[T]) pushTail(e *edit, level uint, parent, tail *vectorNode[T]) *vectorNode[T] {
+	owned := parent.own(e)
+	i := ((v.size - 1) >> level) & mask
+
+	var child *vectorNode[T]
+	switch {
+	case level == bits:
+		child = tail
+	case i < len(parent.children):
+		child = v.pushTail(e, level-bits, parent.children[i], tail)
+	default:
+		child = newPath(e, level-bits, tail)
+	}
+
+	if i < len(owned.children) {
+		owned.children[i] = child
+	} else {
+		owned.children = append(owned.children, child)
+	}
+	return owned
+}
+
+func newPath[T any](e *edit, level uint, node *vectorNode[T]) *vectorNode[T] {
+	if level == 0 {
+		return node
+	}
+	return &vectorNode[T]{edit: e, children: []*vectorNode[T]{newPath(e, level-bits, node)}}
+}
+
+func (v *Vector[T]) pop(e *edit) {
+	if v.size == 1 {
+		*v = Vector[T]{}
+		return
+	}
+
+	if v.size-v.tailOffset() > 1 {
+		if e == nil {
+			v.tail = clone(v.tail)
+		}
+		var zero T
+		v.tail[len(v.tail)-1] = zero
+		v.tail = v.tail[:len(v.tail)-1]
+		v.size--
+		return
+	}
+
+	v.tail = clone(v.leaf(v.size - 2))
+	root := v.popTail(e, v.shift, v.root)
+	switch {
+	case root == nil:
+		v.root, v.shift = nil, 0
+	case v.shift > bits && len(root.children) == 1:
+		v.root, v.shift = root.children[0], v.shift-bits
+	default:
+		v.root = root
+	}
+	v.size--
+}
+
+func (v *Vector[T]) popTail(e *edit, level uint, node *vectorNode[T]) *vectorNode[T] {
+	i := ((v.size - 2) >> level) & mask
+	if level > bits {
+		child := v.popTail(e, level-bits, node.children[i])
+		if child == nil && i == 0 {
+			return nil
+		}
+		owned := node.own(e)
+		if child == nil {
+			owned.children = owned.children[:i]
+		} else {
+			owned.children[i] = child
+		}
+		return owned
+	}
+	if i == 0 {
+		return nil
+	}
+	owned := node.own(e)
+	owned.children = owned.children[:i]
+	return owned
+}
+
+// VectorBuilder is a transient vector for bulk updates. It changes the nodes
+// it created in place instead of copying them on every update. A builder is
+// not safe for concurrent use.
+type VectorBuilder[T any] struct {
+	vector    Vector[T]
+	edit      *edit
+	tailOwned bool
+}
+
+// NewVectorBuilder returns a builder that starts from an empty vector
+func NewVectorBuilder[T any]() *VectorBuilder[T] {
+	return EmptyVector[T]().Transient()
+}
+
+// ownTail copies the tail the first time the builder changes it, since it
+// may still be shared with a persistent vector
+func (b *VectorBuilder[T]) ownTail() {
+	if !b.tailOwned {
+		b.vector.tail = clone(b.vector.tail)
+		b.tailOwned = true
+	}
+}
+
+func (b *VectorBuilder[T]) Append(values ...T) *VectorBuilder[T] {
+	for _, value := range values {
+		b.ownTail()
+		b.vector.append(b.edit, value)
+	}
+	return b
+}
+
+// Set replaces the element at index, reporting false if it is out of range
+func (b *VectorBuilder[T]) Set(index int, value T) bool {
+	if index < 0 || index >= b.vector.size {
+		return false
+	}
+	b.ownTail()
+	b.vector.set(b.edit, index, value)
+	return true
+}
+
+// Pop removes the last element, reporting false if there is none
+func (b *VectorBuilder[T]) Pop() bool {
+	if b.vector.size == 0 {
+		return false
+	}
+	b.ownTail()
+	b.vector.pop(b.edit)
+	return true
+}
+
+func (b *VectorBuilder[T]) Get(index int) (T, bool) {
+	return b.vector.Get(index)
+}
+
+func (b *VectorBuilder[T]) Size() int {
+	return b.vector.size
+}
+
+// Persistent returns the built vector. The builder can keep being used
+// afterwards without affecting the returned vector.
+func (b *VectorBuilder[T]) Persistent() *Vector[T] {
+	built := b.vector
+	b.edit = &edit{}
+	b.tailOwned = false
+	return &built
+}
@@ -0,0 +1,204 @@
+package immutable_test
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/avila-r/ego/immutable"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Vector_Append(t *testing.T) {
+	type Case struct {
+		name string
+		size int
+	}
+
+	// Sizes around the tail and trie level boundaries
+	cases := []Case{
+		{"empty", 0},
+		{"within tail", 31},
+		{"full tail", 32},
+		{"first leaf pushed", 33},
+		{"one level full", 32*32 + 32},
+		{"second level", 32*32 + 33},
+		{"deep", 40_000},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			expected := make([]int, c.size)
+			v := immutable.EmptyVector[int]()
+			for i := range c.size {
+				expected[i] = i
+				v = v.Append(i)
+			}
+
+			assert.Equal(t, c.size, v.Size())
+			assert.Equal(t, expected, v.Elements())
+			for _, i := range []int{0, c.size / 2, c.size - 1} {
+				if i >= 0 && i < c.size {
+					value, ok := v.Get(i)
+					assert.True(t, ok)
+					assert.Equal(t, i, value)
+				}
+			}
+			_, ok := v.Get(c.size)
+			assert.False(t, ok)
+		})
+	}
+}
+
+func Test_Vector_ZeroValue(t *testing.T) {
+	var v immutable.Vector[string]
+
+	assert.True(t, v.IsEmpty())
+	assert.Equal(t, []string{"a"}, v.Append("a").Elements())
+	assert.True(t, v.IsEmpty())
+}
+
+func Test_Vector_IsPersistent(t *testing.T) {
+	base := immutable.VectorOf(naturals(100)...)
+
+	appended := base.Append(100)
+	updated, ok := base.Set(50, -1)
+	assert.True(t, ok)
+	popped, ok := base.Pop()
+	assert.True(t, ok)
+
+	assert.Equal(t, naturals(100), base.Elements())
+	assert.Equal(t, 101, appended.Size())
+	assert.Equal(t, 99, popped.Size())
+
+	value, _ := updated.Get(50)
+	assert.Equal(t, -1, value)
+	value, _ = base.Get(50)
+	assert.Equal(t, 50, value)
+}
+
+func Test_Vector_SetOutOfRange(t *testing.T) {
+	v := immutable.VectorOf(1, 2)
+
+	same, ok := v.Set(2, 3)
+	assert.False(t, ok)
+	assert.Same(t, v, same)
+}
+
+func Test_Vector_Pop(t *testing.T) {
+	v := immutable.VectorOf(naturals(2000)...)
+	for size := 2000; size > 0; size-- {
+		last, _ := v.Last()
+		assert.Equal(t, size-1, last)
+		v, _ = v.Pop()
+		assert.Equal(t, size-1, v.Size())
+	}
+
+	_, ok := v.Pop()
+	assert.False(t, ok)
+	assert.Equal(t, []int{}, v.Elements())
+	assert.Equal(t, []int{7}, v.Append(7).Elements())
+}
+
+func Test_Vector_MatchesSlice(t *testing.T) {
+	random := rand.New(rand.NewSource(5))
+	model := []int{}
+	v := immutable.EmptyVector[int]()
+	versions := map[*immutable.Vector[int]][]int{}
+
+	for i := range 20_000 {
+		switch op := random.Intn(10); {
+		case op < 6:
+			v = v.Append(i)
+			model = append(model, i)
+		case op < 8 && len(model) > 0:
+			index := random.Intn(len(model))
+			v, _ = v.Set(index, -i)
+			model[index] = -i
+		case len(model) > 0:
+			v, _ = v.Pop()
+			model = model[:len(model)-1]
+		}
+		if i%1000 == 0 {
+			versions[v] = slices.Clone(model)
+		}
+	}
+
+	assert.Equal(t, model, v.Elements())
+	for version, expected := range versions {
+		assert.Equal(t, expected, version.Elements())
+	}
+}
+
+func Test_VectorBuilder(t *testing.T) {
+	base := immutable.VectorOf(1, 2, 3)
+
+	builder := base.Transient()
+	builder.Append(naturals(100)...)
+	assert.True(t, builder.Set(0, 10))
+	assert.True(t, builder.Set(50, 500))
+	assert.True(t, builder.Pop())
+	assert.False(t, builder.Set(1000, 0))
+
+	built := builder.Persistent()
+	assert.Equal(t, []int{1, 2, 3}, base.Elements())
+	assert.Equal(t, 102, built.Size())
+	first, _ := built.Get(0)
+	assert.Equal(t, 10, first)
+
+	builder.Set(0, 20)
+	builder.Append(-1)
+	first, _ = built.Get(0)
+	assert.Equal(t, 10, first)
+	assert.Equal(t, 102, built.Size())
+	assert.Equal(t, 103, builder.Size())
+}
+
+func Test_Vector_Iteration(t *testing.T) {
+	v := immutable.VectorOf(naturals(70)...)
+
+	assert.Equal(t, naturals(70), v.Iterator().Collect())
+	assert.Equal(t, []int{0, 1, 2}, v.Stream().Limit(3).ToSlice())
+
+	sum := 0
+	v.ForEach(func(n int) { sum += n })
+	assert.Equal(t, 69*70/2, sum)
+}
+
+func naturals(n int) []int {
+	values := make([]int, n)
+	for i := range values {
+		values[i] = i
+	}
+	return values
+}
+
+func Test_VectorBuilder_MatchesSlice(t *testing.T) {
+	random := rand.New(rand.NewSource(9))
+	model := []int{}
+	builder := immutable.NewVectorBuilder[int]()
+	snapshots := map[*immutable.Vector[int]][]int{}
+
+	for i := range 20_000 {
+		switch op := random.Intn(10); {
+		case op < 6:
+			builder.Append(i)
+			model = append(model, i)
+		case op < 8 && len(model) > 0:
+			index := random.Intn(len(model))
+			builder.Set(index, -i)
+			model[index] = -i
+		case len(model) > 0:
+			builder.Pop()
+			model = model[:len(model)-1]
+		}
+		if i%997 == 0 {
+			snapshots[builder.Persistent()] = slices.Clone(model)
+		}
+	}
+
+	assert.Equal(t, model, builder.Persistent().Elements())
+	for snapshot, expected := range snapshots {
+		assert.Equal(t, expected, snapshot.Elements())
+	}
+}
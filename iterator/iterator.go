@@ -105,23 +105,20 @@ func (it *SliceIterator[T]) ForEach(action func(T)) {
 	}
 }
 
-// Filter returns a new iterator with only elements matching the predicate
+// Filter returns a lazy iterator with only elements matching the predicate.
+// The predicate is evaluated as elements are pulled from the returned iterator.
 func (it *SliceIterator[T]) Filter(predicate func(T) bool) Iterator[T] {
-	filtered := make([]T, 0)
-	for it.HasNext() {
-		elem := it.Next()
-		if predicate(elem) {
-			filtered = append(filtered, elem)
-		}
-	}
-	return Of(filtered...)
+	return filter[T](it, predicate)
 }
 
-// Map transforms elements to a new type
-func Map[T, U comparable](it Iterator[T], mapper func(T) U) Iterator[U] {
-	mapped := make([]U, 0)
-	for it.HasNext() {
-		mapped = append(mapped, mapper(it.Next()))
-	}
-	return Of(mapped...)
+// Map returns a lazy iterator that transforms elements to a new type.
+// The mapper is evaluated as elements are pulled from the returned iterator.
+func Map[T, U any](it Iterator[T], mapper func(T) U) Iterator[U] {
+	return FromFunc(func() (U, bool) {
+		if !it.HasNext() {
+			var zero U
+			return zero, false
+		}
+		return mapper(it.Next()), true
+	}, it.Reset)
 }
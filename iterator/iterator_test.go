@@ -1,7 +1,10 @@
 package iterator_test
 
 import (
+	"runtime"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/avila-r/ego/collection"
 	"github.com/avila-r/ego/iterator"
@@ -386,16 +389,26 @@ func Test_Filter(t *testing.T) {
 	}
 }
 
-func Test_Filter_ExhaustsOriginal(t *testing.T) {
+func Test_Filter_IsLazy(t *testing.T) {
 	it := iterator.Of(1, 2, 3, 4, 5)
 
-	filtered := it.Filter(func(n int) bool { return n%2 == 0 })
+	calls := 0
+	filtered := it.Filter(func(n int) bool {
+		calls++
+		return n%2 == 0
+	})
 
-	// Original iterator should be exhausted
-	assert.False(t, it.HasNext())
+	// Nothing is evaluated until the filtered iterator is pulled
+	assert.Equal(t, 0, calls)
+	assert.Equal(t, 5, it.Remaining())
+
+	// Pulling one element only consumes what is needed to find it
+	assert.Equal(t, 2, filtered.Next())
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, 3, it.Remaining())
 
-	// Filtered iterator should work
-	assert.True(t, filtered.HasNext())
+	assert.Equal(t, []int{4}, filtered.Collect())
+	assert.False(t, it.HasNext())
 }
 
 func Test_Map(t *testing.T) {
@@ -465,16 +478,147 @@ func Test_Map_TypeTransformation(t *testing.T) {
 	assert.Equal(t, expected, result)
 }
 
-func Test_Map_ExhaustsOriginal(t *testing.T) {
+func Test_Map_IsLazy(t *testing.T) {
+	it := iterator.Of(1, 2, 3)
+
+	calls := 0
+	mapped := iterator.Map(it, func(n int) int {
+		calls++
+		return n * 2
+	})
+
+	// Nothing is evaluated until the mapped iterator is pulled
+	assert.Equal(t, 0, calls)
+	assert.Equal(t, 3, it.Remaining())
+
+	// Peek evaluates a single element without advancing
+	assert.Equal(t, 2, mapped.Peek())
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, 2, mapped.Next())
+	assert.Equal(t, 1, calls)
+
+	assert.Equal(t, 2, mapped.Remaining())
+	assert.Equal(t, []int{4, 6}, mapped.Collect())
+}
+
+func Test_Map_Reset(t *testing.T) {
 	it := iterator.Of(1, 2, 3)
+	mapped := iterator.Map(it, func(n int) int { return n * 10 })
 
-	mapped := iterator.Map(it, func(n int) int { return n * 2 })
+	assert.Equal(t, 10, mapped.Next())
+	mapped.Reset()
 
-	// Original iterator should be exhausted
+	assert.Equal(t, []int{10, 20, 30}, mapped.Collect())
+}
+
+func Test_FromFunc(t *testing.T) {
+	n := 0
+	it := iterator.FromFunc(func() (int, bool) {
+		if n >= 3 {
+			return 0, false
+		}
+		n++
+		return n, true
+	}, func() { n = 0 })
+
+	assert.Equal(t, []int{1, 2, 3}, it.Collect())
 	assert.False(t, it.HasNext())
+	assert.Panics(t, func() {
+		it.Next()
+	})
+
+	it.Reset()
+	assert.Equal(t, 3, it.Remaining())
+	assert.Equal(t, 1, it.Next())
+}
+
+func Test_FromSeq(t *testing.T) {
+	type Case struct {
+		name     string
+		elements []int
+		expected []int
+	}
+
+	cases := []Case{
+		{"empty sequence", []int{}, []int{}},
+		{"single element", []int{1}, []int{1}},
+		{"multiple elements", []int{1, 2, 3}, []int{1, 2, 3}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			it := iterator.FromSeq(slices.Values(c.elements))
+
+			result := slice.Empty[int]()
+			for it.HasNext() {
+				result = append(result, it.Next())
+			}
+
+			assert.Equal(t, c.expected, result)
+		})
+	}
+}
+
+func Test_FromSeq_Reset(t *testing.T) {
+	it := iterator.FromSeq(slices.Values([]int{1, 2, 3}))
+
+	assert.Equal(t, 1, it.Next())
+	assert.Equal(t, 2, it.Next())
+	it.Reset()
+
+	assert.Equal(t, []int{1, 2, 3}, it.Collect())
+}
+
+func Test_FromSeq_ReleasedWhenAbandoned(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	for range 10 {
+		it := iterator.FromSeq(slices.Values([]int{1, 2, 3}))
+		assert.True(t, it.HasNext())
+	}
+
+	// assert.Eventually runs on goroutines of its own, so poll by hand
+	for range 100 {
+		runtime.GC()
+		if runtime.NumGoroutine() <= before {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Errorf("%d pull goroutines still running", runtime.NumGoroutine()-before)
+}
+
+func Test_Iterate(t *testing.T) {
+	it := iterator.Iterate(
+		func() (int, bool) { return 1, true },
+		func(v int) (int, bool) { return v * 2, v < 8 },
+	)
+
+	assert.Equal(t, []int{1, 2, 4, 8}, it.Collect())
+
+	it.Reset()
+	assert.Equal(t, 1, it.Next())
+
+	empty := iterator.Iterate(
+		func() (int, bool) { return 0, false },
+		func(v int) (int, bool) { return v, true },
+	)
+	assert.False(t, empty.HasNext())
+}
+
+func Test_ToSeq(t *testing.T) {
+	it := iterator.Of(1, 2, 3, 4, 5)
+	it.Next()
+
+	result := slice.Empty[int]()
+	for v := range iterator.ToSeq(it) {
+		if v > 4 {
+			break
+		}
+		result = append(result, v)
+	}
 
-	// Mapped iterator should work
-	assert.True(t, mapped.HasNext())
+	assert.Equal(t, []int{2, 3, 4}, result)
 }
 
 func Test_Iterator_ComplexWorkflow(t *testing.T) {
@@ -0,0 +1,142 @@
+package iterator
+
+// LazyIterator pulls elements from a source function on demand. Elements are
+// only buffered when Peek or Remaining need to look ahead.
+type LazyIterator[T any] struct {
+	pull   func() (T, bool)
+	reset  func()
+	buffer []T
+	done   bool
+}
+
+// Ensure LazyIterator implements Iterator
+var _ Iterator[int] = (*LazyIterator[int])(nil)
+
+// FromFunc creates a lazy iterator that calls next each time a new element is
+// needed, until next reports false. reset is called by Reset to rewind the
+// source and may be nil if the source cannot be rewound.
+func FromFunc[T any](next func() (T, bool), reset func()) Iterator[T] {
+	return &LazyIterator[T]{
+		pull:  next,
+		reset: reset,
+	}
+}
+
+// Iterate creates a lazy iterator that starts at the element returned by first
+// and steps to the next one with next, until either reports false. Only the
+// current element is kept, so sorted collections can iterate by looking up
+// each successor.
+func Iterate[T any](first func() (T, bool), next func(T) (T, bool)) Iterator[T] {
+	var (
+		current T
+		started bool
+	)
+
+	pull := func() (T, bool) {
+		var ok bool
+		if !started {
+			current, ok = first()
+			started = true
+		} else {
+			current, ok = next(current)
+		}
+		return current, ok
+	}
+
+	reset := func() {
+		var zero T
+		current, started = zero, false
+	}
+
+	return FromFunc(pull, reset)
+}
+
+// fill pulls from the source until the buffer holds at least n elements or the
+// source is exhausted, and reports whether n elements are available
+func (it *LazyIterator[T]) fill(n int) bool {
+	for len(it.buffer) < n && !it.done {
+		element, ok := it.pull()
+		if !ok {
+			it.done = true
+			break
+		}
+		it.buffer = append(it.buffer, element)
+	}
+	return len(it.buffer) >= n
+}
+
+// HasNext returns true if there are more elements to iterate
+func (it *LazyIterator[T]) HasNext() bool {
+	return it.fill(1)
+}
+
+// Next returns the next element and advances the iterator
+func (it *LazyIterator[T]) Next() T {
+	if !it.fill(1) {
+		ErrExhausted.Panic()
+	}
+	element := it.buffer[0]
+	var zero T
+	it.buffer[0] = zero
+	it.buffer = it.buffer[1:]
+	return element
+}
+
+// Peek returns the next element without advancing the iterator
+func (it *LazyIterator[T]) Peek() T {
+	if !it.fill(1) {
+		ErrExhausted.Panic()
+	}
+	return it.buffer[0]
+}
+
+// Reset resets the iterator to the beginning of its source
+func (it *LazyIterator[T]) Reset() {
+	if it.reset != nil {
+		it.reset()
+	}
+	it.buffer = nil
+	it.done = false
+}
+
+// Remaining returns the number of elements left to iterate.
+// The rest of the source is evaluated and buffered to count them.
+func (it *LazyIterator[T]) Remaining() int {
+	for !it.done {
+		it.fill(len(it.buffer) + 1)
+	}
+	return len(it.buffer)
+}
+
+// Collect collects all remaining elements into a slice
+func (it *LazyIterator[T]) Collect() []T {
+	result := make([]T, 0, len(it.buffer))
+	for it.HasNext() {
+		result = append(result, it.Next())
+	}
+	return result
+}
+
+// ForEach applies a function to all remaining elements
+func (it *LazyIterator[T]) ForEach(action func(T)) {
+	for it.HasNext() {
+		action(it.Next())
+	}
+}
+
+// Filter returns a lazy iterator with only elements matching the predicate
+func (it *LazyIterator[T]) Filter(predicate func(T) bool) Iterator[T] {
+	return filter[T](it, predicate)
+}
+
+func filter[T any](source Iterator[T], predicate func(T) bool) Iterator[T] {
+	return FromFunc(func() (T, bool) {
+		for source.HasNext() {
+			if element := source.Next(); predicate(element) {
+				return element, true
+			}
+		}
+		var zero T
+		return zero, false
+	}, source.Reset)
+}
@@ -0,0 +1,55 @@
+package iterator
+
+import (
+	"iter"
+	"runtime"
+)
+
+// pulled holds the paused sequence behind a FromSeq iterator
+type pulled[T any] struct {
+	next func() (T, bool)
+	stop func()
+}
+
+func (p *pulled[T]) release() {
+	if p.stop != nil {
+		p.stop()
+	}
+	p.next, p.stop = nil, nil
+}
+
+// FromSeq creates a lazy iterator over a Go range-over-func sequence.
+// The sequence is pulled one element at a time on its own goroutine, which
+// is released once the iterator is exhausted, reset or garbage collected.
+// Collections iterate with cursors instead; use FromSeq for sequences that
+// have no other way to be pulled.
+func FromSeq[T any](seq iter.Seq[T]) Iterator[T] {
+	state := &pulled[T]{}
+
+	pull := func() (T, bool) {
+		if state.next == nil {
+			state.next, state.stop = iter.Pull(seq)
+		}
+		element, ok := state.next()
+		if !ok {
+			state.release()
+		}
+		return element, ok
+	}
+
+	it := FromFunc(pull, state.release)
+	runtime.AddCleanup(it.(*LazyIterator[T]), (*pulled[T]).release, state)
+	return it
+}
+
+// ToSeq adapts the remaining elements of an iterator into a sequence
+// that can be used with range
+func ToSeq[T any](it Iterator[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for it.HasNext() {
+			if !yield(it.Next()) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package list
+
+import (
+	"iter"
+
+	"github.com/avila-r/ego/collection"
+	"github.com/avila-r/ego/iterator"
+	"github.com/avila-r/ego/stream"
+)
+
+const minDequeCapacity = 8
+
+// ArrayDeque is a deque backed by a growable ring buffer. Adding and removing
+// at either end is amortized O(1) and indexed access is O(1).
+type ArrayDeque[T any] struct {
+	elements []T
+	head     int
+	size     int
+}
+
+var _ collection.Deque[int] = (*ArrayDeque[int])(nil)
+
+func NewArrayDeque[T any](items ...T) *ArrayDeque[T] {
+	d := SizedArrayDeque[T](len(items))
+	for _, item := range items {
+		d.AddLast(item)
+	}
+	return d
+}
+
+func EmptyArrayDeque[T any]() *ArrayDeque[T] {
+	return SizedArrayDeque[T](minDequeCapacity)
+}
+
+// SizedArrayDeque creates an empty deque that holds capacity elements before
+// it needs to grow
+func SizedArrayDeque[T any](capacity int) *ArrayDeque[T] {
+	return &ArrayDeque[T]{
+		elements: make([]T, max(capacity, minDequeCapacity)),
+	}
+}
+
+// slot maps a logical index to its position in the ring buffer
+func (d *ArrayDeque[T]) slot(index int) int {
+	return (d.head + index) % len(d.elements)
+}
+
+// at returns the element at a logical index known to be in range
+func (d *ArrayDeque[T]) at(index int) T {
+	return d.elements[d.slot(index)]
+}
+
+func (d *ArrayDeque[T]) grow() {
+	if d.size < len(d.elements) {
+		return
+	}
+	elements := make([]T, max(len(d.elements)*2, minDequeCapacity))
+	n := copy(elements, d.elements[d.head:])
+	copy(elements[n:], d.elements[:d.head])
+	d.elements, d.head = elements, 0
+}
+
+func (d *ArrayDeque[T]) AddFirst(item T) {
+	d.grow()
+	d.head = (d.head - 1 + len(d.elements)) % len(d.elements)
+	d.elements[d.head] = item
+	d.size++
+}
+
+func (d *ArrayDeque[T]) AddLast(item T) {
+	d.grow()
+	d.elements[d.slot(d.size)] = item
+	d.size++
+}
+
+func (d *ArrayDeque[T]) PollFirst() (T, bool) {
+	var zero T
+	if d.size == 0 {
+		return zero, false
+	}
+	item := d.elements[d.head]
+	d.elements[d.head] = zero
+	d.head = d.slot(1)
+	d.size--
+	return item, true
+}
+
+func (d *ArrayDeque[T]) PollLast() (T, bool) {
+	var zero T
+	if d.size == 0 {
+		return zero, false
+	}
+	last := d.slot(d.size - 1)
+	item := d.elements[last]
+	d.elements[last] = zero
+	d.size--
+	return item, true
+}
+
+func (d *ArrayDeque[T]) PeekFirst() (T, bool) {
+	return d.Get(0)
+}
+
+func (d *ArrayDeque[T]) PeekLast() (T, bool) {
+	return d.Get(d.size - 1)
+}
+
+func (d *ArrayDeque[T]) Get(index int) (T, bool) {
+	var zero T
+	if index < 0 || index >= d.size {
+		return zero, false
+	}
+	return d.elements[d.slot(index)], true
+}
+
+func (d *ArrayDeque[T]) Size() int {
+	return d.size
+}
+
+func (d *ArrayDeque[T]) IsEmpty() bool {
+	return d.size == 0
+}
+
+func (d *ArrayDeque[T]) Clear() {
+	clear(d.elements)
+	d.head, d.size = 0, 0
+}
+
+func (d *ArrayDeque[T]) Elements() []T {
+	elements := make([]T, 0, d.size)
+	for i := range d.size {
+		elements = append(elements, d.elements[d.slot(i)])
+	}
+	return elements
+}
+
+func (d *ArrayDeque[T]) Stream() stream.Stream[T] {
+	return stream.FromSeq(d.All())
+}
+
+func (d *ArrayDeque[T]) ForEach(action func(T)) {
+	for i := range d.size {
+		action(d.elements[d.slot(i)])
+	}
+}
+
+func (d *ArrayDeque[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < d.size; i++ {
+			if !yield(d.elements[d.slot(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns a sequence over the elements from last to first
+func (d *ArrayDeque[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := d.size - 1; i >= 0; i-- {
+			if !yield(d.elements[d.slot(i)]) {
+				return
+			}
+		}
+	}
+}
+
+func (d *ArrayDeque[T]) Iterator() iterator.Iterator[T] {
+	indexes := iterator.Iterate(
+		func() (int, bool) { return 0, d.size > 0 },
+		func(i int) (int, bool) { return i + 1, i+1 < d.size },
+	)
+	return iterator.Map(indexes, d.at)
+}
+
+// DescendingIterator returns an iterator over the elements from last to first
+func (d *ArrayDeque[T]) DescendingIterator() iterator.Iterator[T] {
+	indexes := iterator.Iterate(
+		func() (int, bool) { return d.size - 1, d.size > 0 },
+		func(i int) (int, bool) { return i - 1, i > 0 },
+	)
+	return iterator.Map(indexes, d.at)
+}
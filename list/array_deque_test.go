@@ -0,0 +1,98 @@
+package list_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/avila-r/ego/list"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeque_AddAndPoll(t *testing.T) {
+	d := list.EmptyArrayDeque[int]()
+
+	_, ok := d.PollFirst()
+	assert.False(t, ok)
+	_, ok = d.PeekFirst()
+	assert.False(t, ok)
+
+	d.AddLast(2)
+	d.AddLast(3)
+	d.AddFirst(1)
+
+	first, _ := d.PeekFirst()
+	last, _ := d.PeekLast()
+	assert.Equal(t, 1, first)
+	assert.Equal(t, 3, last)
+	assert.Equal(t, []int{1, 2, 3}, d.Elements())
+
+	first, _ = d.PollFirst()
+	last, _ = d.PollLast()
+	assert.Equal(t, 1, first)
+	assert.Equal(t, 3, last)
+	assert.Equal(t, 1, d.Size())
+}
+
+func TestDeque_WrapsAndGrows(t *testing.T) {
+	d := list.SizedArrayDeque[int](8)
+	expected := []int{}
+
+	for i := range 100 {
+		if i%3 == 0 {
+			d.AddFirst(i)
+			expected = append([]int{i}, expected...)
+		} else {
+			d.AddLast(i)
+			expected = append(expected, i)
+		}
+		if i%5 == 0 {
+			d.PollFirst()
+			expected = expected[1:]
+		}
+	}
+
+	assert.Equal(t, expected, d.Elements())
+	assert.Equal(t, expected, slices.Collect(d.All()))
+
+	for i := range expected {
+		value, ok := d.Get(i)
+		assert.True(t, ok)
+		assert.Equal(t, expected[i], value)
+	}
+}
+
+func TestDeque_AsStack(t *testing.T) {
+	d := list.NewArrayDeque[string]()
+	for _, action := range []string{"type", "bold", "delete"} {
+		d.AddLast(action)
+	}
+
+	undone := []string{}
+	for !d.IsEmpty() {
+		action, _ := d.PollLast()
+		undone = append(undone, action)
+	}
+
+	assert.Equal(t, []string{"delete", "bold", "type"}, undone)
+}
+
+func TestDeque_Iterators(t *testing.T) {
+	d := list.NewArrayDeque(1, 2, 3)
+	d.AddFirst(0)
+
+	assert.Equal(t, []int{0, 1, 2, 3}, d.Iterator().Collect())
+	assert.Equal(t, []int{3, 2, 1, 0}, d.DescendingIterator().Collect())
+	assert.Equal(t, []int{3, 2}, slices.Collect(d.Backward())[:2])
+	assert.Equal(t, []int{0, 1}, d.Stream().Limit(2).ToSlice())
+}
+
+func TestDeque_Clear(t *testing.T) {
+	d := list.NewArrayDeque(1, 2, 3)
+	d.Clear()
+
+	assert.True(t, d.IsEmpty())
+	assert.Equal(t, []int{}, d.Elements())
+
+	d.AddFirst(4)
+	assert.Equal(t, []int{4}, d.Elements())
+}
@@ -1,9 +1,11 @@
 package list
 
 import (
+	"iter"
 	"slices"
 
 	"github.com/avila-r/ego/collection"
+	"github.com/avila-r/ego/function"
 	"github.com/avila-r/ego/iterator"
 	"github.com/avila-r/ego/stream"
 )
@@ -16,7 +18,7 @@ var _ collection.List[int] = (*ArrayList[int])(nil)
 
 func NewArrayList[T comparable](items ...T) *ArrayList[T] {
 	return &ArrayList[T]{
-		elements: items,
+		elements: slices.Clone(items),
 	}
 }
 
@@ -54,6 +56,59 @@ func (l *ArrayList[T]) Remove(index int) bool {
 	return true
 }
 
+func (l *ArrayList[T]) Insert(index int, items ...T) bool {
+	if index < 0 || index > len(l.elements) {
+		return false
+	}
+	l.elements = slices.Insert(l.elements, index, items...)
+	return true
+}
+
+func (l *ArrayList[T]) IndexOf(value T) int {
+	return slices.Index(l.elements, value)
+}
+
+func (l *ArrayList[T]) LastIndexOf(value T) int {
+	for i := len(l.elements) - 1; i >= 0; i-- {
+		if l.elements[i] == value {
+			return i
+		}
+	}
+	return -1
+}
+
+func (l *ArrayList[T]) SubList(from, to int) collection.List[T] {
+	return newSubList(l, from, to)
+}
+
+func (l *ArrayList[T]) Sort(comparator function.Comparator[T]) {
+	slices.SortStableFunc(l.elements, comparator.Compare)
+}
+
+func (l *ArrayList[T]) BinarySearch(value T, comparator function.Comparator[T]) (int, bool) {
+	return slices.BinarySearchFunc(l.elements, value, comparator.Compare)
+}
+
+func (l *ArrayList[T]) RemoveIf(predicate function.Predicate[T]) bool {
+	size := len(l.elements)
+	l.elements = slices.DeleteFunc(l.elements, predicate.Test)
+	return len(l.elements) != size
+}
+
+func (l *ArrayList[T]) ReplaceAll(operator function.UnaryOperator[T]) {
+	for i, item := range l.elements {
+		l.elements[i] = operator.Apply(item)
+	}
+}
+
+func (l *ArrayList[T]) RetainAll(items ...T) bool {
+	return l.RemoveIf(notIn(items))
+}
+
+func (l *ArrayList[T]) Equals(other collection.List[T]) bool {
+	return equal(l, other)
+}
+
 func (l *ArrayList[T]) Contains(value T) bool {
 	return slices.Contains(l.elements, value)
 }
@@ -70,12 +125,14 @@ func (l *ArrayList[T]) Clear() {
 	l.elements = []T{}
 }
 
+// Items returns a copy of the elements in the list
 func (l *ArrayList[T]) Items() []T {
-	return l.elements
+	return l.Elements()
 }
 
+// Elements returns a copy of the elements in the list
 func (l *ArrayList[T]) Elements() []T {
-	return l.elements
+	return append([]T{}, l.elements...)
 }
 
 func (l *ArrayList[T]) Stream() stream.Stream[T] {
@@ -88,6 +145,10 @@ func (l *ArrayList[T]) ForEach(action func(T)) {
 	}
 }
 
+func (l *ArrayList[T]) All() iter.Seq[T] {
+	return slices.Values(l.elements)
+}
+
 func (l *ArrayList[T]) Iterator() iterator.Iterator[T] {
 	return iterator.From(l)
 }
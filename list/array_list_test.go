@@ -338,3 +338,15 @@ func Test_Stream(t *testing.T) {
 		})
 	}
 }
+
+func Test_Elements_IsCopy(t *testing.T) {
+	source := []int{1, 2, 3}
+	l := list.NewArrayList(source...)
+
+	l.Elements()[0] = 10
+	l.Items()[1] = 20
+	l.Set(2, 30)
+
+	assert.Equal(t, []int{1, 2, 30}, l.Elements())
+	assert.Equal(t, []int{1, 2, 3}, source)
+}
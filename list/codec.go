@@ -0,0 +1,50 @@
+package list
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+)
+
+// MarshalJSON encodes the list as a JSON array
+func (l *ArrayList[T]) MarshalJSON() ([]byte, error) {
+	if l.elements == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(l.elements)
+}
+
+// UnmarshalJSON replaces the list's contents with a JSON array
+func (l *ArrayList[T]) UnmarshalJSON(data []byte) error {
+	var elements []T
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+	if elements == nil {
+		elements = []T{}
+	}
+	l.elements = elements
+	return nil
+}
+
+// MarshalBinary encodes the list with encoding/gob
+func (l *ArrayList[T]) MarshalBinary() ([]byte, error) {
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(l.elements); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// UnmarshalBinary replaces the list's contents with gob-encoded data
+func (l *ArrayList[T]) UnmarshalBinary(data []byte) error {
+	var elements []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&elements); err != nil {
+		return err
+	}
+	if elements == nil {
+		elements = []T{}
+	}
+	l.elements = elements
+	return nil
+}
@@ -0,0 +1,65 @@
+package list_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+
+	"github.com/avila-r/ego/list"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ArrayList_JSON(t *testing.T) {
+	type Case struct {
+		name    string
+		list    *list.ArrayList[int]
+		encoded string
+	}
+
+	cases := []Case{
+		{"elements", list.NewArrayList(3, 1, 2), `[3,1,2]`},
+		{"empty", list.EmptyArrayList[int](), `[]`},
+		{"zero value", &list.ArrayList[int]{}, `[]`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			encoded, err := json.Marshal(c.list)
+			assert.NoError(t, err)
+			assert.JSONEq(t, c.encoded, string(encoded))
+
+			decoded := list.NewArrayList(9)
+			assert.NoError(t, json.Unmarshal(encoded, decoded))
+			assert.Equal(t, c.list.Size(), decoded.Size())
+			assert.True(t, c.list.Equals(decoded))
+		})
+	}
+}
+
+func Test_ArrayList_JSON_InStruct(t *testing.T) {
+	type DTO struct {
+		Tags *list.ArrayList[string] `json:"tags"`
+	}
+
+	var dto DTO
+	assert.NoError(t, json.Unmarshal([]byte(`{"tags":["a","b"]}`), &dto))
+	assert.Equal(t, []string{"a", "b"}, dto.Tags.Elements())
+
+	encoded, err := json.Marshal(dto)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"tags":["a","b"]}`, string(encoded))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"tags":{}}`), &dto))
+}
+
+func Test_ArrayList_Gob(t *testing.T) {
+	original := list.NewArrayList("x", "y", "z")
+
+	var buffer bytes.Buffer
+	assert.NoError(t, gob.NewEncoder(&buffer).Encode(original))
+
+	decoded := list.EmptyArrayList[string]()
+	assert.NoError(t, gob.NewDecoder(&buffer).Decode(decoded))
+	assert.Equal(t, original.Elements(), decoded.Elements())
+}
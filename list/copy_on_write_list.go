@@ -0,0 +1,223 @@
+package list
+
+import (
+	"iter"
+	"slices"
+	"sync"
+	"sync/atomic"
+
+	"github.com/avila-r/ego/collection"
+	"github.com/avila-r/ego/function"
+	"github.com/avila-r/ego/iterator"
+	"github.com/avila-r/ego/stream"
+)
+
+// CopyOnWriteList is a list that is safe for concurrent use and tuned for
+// reads, such as listener registries. Every write copies the backing slice,
+// so reads never lock and iteration always runs over the snapshot taken when
+// it started. The zero value is an empty list ready to use.
+type CopyOnWriteList[T comparable] struct {
+	mu       sync.Mutex
+	elements atomic.Pointer[[]T]
+}
+
+var _ collection.List[int] = (*CopyOnWriteList[int])(nil)
+
+func NewCopyOnWriteList[T comparable](items ...T) *CopyOnWriteList[T] {
+	l := &CopyOnWriteList[T]{}
+	l.Add(items...)
+	return l
+}
+
+func EmptyCopyOnWriteList[T comparable]() *CopyOnWriteList[T] {
+	return &CopyOnWriteList[T]{}
+}
+
+// snapshot returns the current backing slice, which must never be modified
+func (l *CopyOnWriteList[T]) snapshot() []T {
+	if elements := l.elements.Load(); elements != nil {
+		return *elements
+	}
+	return nil
+}
+
+// write runs update over a copy of the elements and publishes the result if
+// update reports a change
+func (l *CopyOnWriteList[T]) write(update func(elements []T) ([]T, bool)) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	elements, changed := update(slices.Clone(l.snapshot()))
+	if changed {
+		l.elements.Store(&elements)
+	}
+	return changed
+}
+
+func (l *CopyOnWriteList[T]) Add(items ...T) {
+	l.write(func(elements []T) ([]T, bool) {
+		return append(elements, items...), len(items) > 0
+	})
+}
+
+// AddIfAbsent appends item unless the list already contains it, and reports
+// whether it was added
+func (l *CopyOnWriteList[T]) AddIfAbsent(item T) bool {
+	return l.write(func(elements []T) ([]T, bool) {
+		if slices.Contains(elements, item) {
+			return elements, false
+		}
+		return append(elements, item), true
+	})
+}
+
+// RemoveElement removes the first occurrence of item and reports whether it
+// was found
+func (l *CopyOnWriteList[T]) RemoveElement(item T) bool {
+	return l.write(func(elements []T) ([]T, bool) {
+		index := slices.Index(elements, item)
+		if index < 0 {
+			return elements, false
+		}
+		return slices.Delete(elements, index, index+1), true
+	})
+}
+
+func (l *CopyOnWriteList[T]) Get(index int) (T, bool) {
+	var zero T
+	elements := l.snapshot()
+	if index < 0 || index >= len(elements) {
+		return zero, false
+	}
+	return elements[index], true
+}
+
+func (l *CopyOnWriteList[T]) Set(index int, value T) bool {
+	return l.write(func(elements []T) ([]T, bool) {
+		if index < 0 || index >= len(elements) {
+			return elements, false
+		}
+		elements[index] = value
+		return elements, true
+	})
+}
+
+func (l *CopyOnWriteList[T]) Remove(index int) bool {
+	return l.write(func(elements []T) ([]T, bool) {
+		if index < 0 || index >= len(elements) {
+			return elements, false
+		}
+		return slices.Delete(elements, index, index+1), true
+	})
+}
+
+func (l *CopyOnWriteList[T]) Insert(index int, items ...T) bool {
+	return l.write(func(elements []T) ([]T, bool) {
+		if index < 0 || index > len(elements) {
+			return elements, false
+		}
+		return slices.Insert(elements, index, items...), true
+	})
+}
+
+func (l *CopyOnWriteList[T]) IndexOf(value T) int {
+	return slices.Index(l.snapshot(), value)
+}
+
+func (l *CopyOnWriteList[T]) LastIndexOf(value T) int {
+	elements := l.snapshot()
+	for i := len(elements) - 1; i >= 0; i-- {
+		if elements[i] == value {
+			return i
+		}
+	}
+	return -1
+}
+
+// SubList returns a new CopyOnWriteList holding a copy of the range [from, to).
+// Unlike other lists it is not a live view: a view's bounds would go stale as
+// soon as another goroutine wrote to the list.
+func (l *CopyOnWriteList[T]) SubList(from, to int) collection.List[T] {
+	elements := l.snapshot()
+	if from < 0 || to > len(elements) || from > to {
+		ErrIndexOutOfRange.Panic()
+	}
+	return NewCopyOnWriteList(elements[from:to]...)
+}
+
+func (l *CopyOnWriteList[T]) Sort(comparator function.Comparator[T]) {
+	l.write(func(elements []T) ([]T, bool) {
+		slices.SortStableFunc(elements, comparator.Compare)
+		return elements, true
+	})
+}
+
+func (l *CopyOnWriteList[T]) BinarySearch(value T, comparator function.Comparator[T]) (int, bool) {
+	return slices.BinarySearchFunc(l.snapshot(), value, comparator.Compare)
+}
+
+func (l *CopyOnWriteList[T]) RemoveIf(predicate function.Predicate[T]) bool {
+	return l.write(func(elements []T) ([]T, bool) {
+		size := len(elements)
+		elements = slices.DeleteFunc(elements, predicate.Test)
+		return elements, len(elements) != size
+	})
+}
+
+func (l *CopyOnWriteList[T]) ReplaceAll(operator function.UnaryOperator[T]) {
+	l.write(func(elements []T) ([]T, bool) {
+		for i, item := range elements {
+			elements[i] = operator.Apply(item)
+		}
+		return elements, true
+	})
+}
+
+func (l *CopyOnWriteList[T]) RetainAll(items ...T) bool {
+	return l.RemoveIf(notIn(items))
+}
+
+func (l *CopyOnWriteList[T]) Equals(other collection.List[T]) bool {
+	return equal(l, other)
+}
+
+func (l *CopyOnWriteList[T]) Contains(value T) bool {
+	return slices.Contains(l.snapshot(), value)
+}
+
+func (l *CopyOnWriteList[T]) Size() int {
+	return len(l.snapshot())
+}
+
+func (l *CopyOnWriteList[T]) IsEmpty() bool {
+	return len(l.snapshot()) == 0
+}
+
+func (l *CopyOnWriteList[T]) Clear() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.elements.Store(&[]T{})
+}
+
+// Elements returns a copy of the elements in the list
+func (l *CopyOnWriteList[T]) Elements() []T {
+	return append([]T{}, l.snapshot()...)
+}
+
+func (l *CopyOnWriteList[T]) Stream() stream.Stream[T] {
+	return stream.From(l)
+}
+
+func (l *CopyOnWriteList[T]) ForEach(action func(T)) {
+	for _, item := range l.snapshot() {
+		action(item)
+	}
+}
+
+func (l *CopyOnWriteList[T]) All() iter.Seq[T] {
+	return slices.Values(l.snapshot())
+}
+
+func (l *CopyOnWriteList[T]) Iterator() iterator.Iterator[T] {
+	return iterator.Of(l.snapshot()...)
+}
@@ -0,0 +1,124 @@
+package list_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/avila-r/ego/list"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCopyOnWrite_ZeroValue(t *testing.T) {
+	var l list.CopyOnWriteList[int]
+
+	assert.True(t, l.IsEmpty())
+	assert.Equal(t, []int{}, l.Elements())
+
+	l.Add(1, 2)
+	assert.Equal(t, []int{1, 2}, l.Elements())
+}
+
+func TestCopyOnWrite_AddIfAbsent(t *testing.T) {
+	l := list.NewCopyOnWriteList(1, 2)
+
+	assert.True(t, l.AddIfAbsent(3))
+	assert.False(t, l.AddIfAbsent(1))
+	assert.Equal(t, []int{1, 2, 3}, l.Elements())
+}
+
+func TestCopyOnWrite_RemoveElement(t *testing.T) {
+	l := list.NewCopyOnWriteList(1, 2, 1)
+
+	assert.True(t, l.RemoveElement(1))
+	assert.False(t, l.RemoveElement(5))
+	assert.Equal(t, []int{2, 1}, l.Elements())
+}
+
+func TestCopyOnWrite_IterationIsSnapshot(t *testing.T) {
+	l := list.NewCopyOnWriteList(1, 2, 3)
+
+	var seen []int
+	for v := range l.All() {
+		l.Add(v * 10)
+		l.RemoveElement(3)
+		seen = append(seen, v)
+	}
+
+	assert.Equal(t, []int{1, 2, 3}, seen)
+	assert.Equal(t, []int{1, 2, 10, 20, 30}, l.Elements())
+
+	it := l.Iterator()
+	l.Clear()
+	assert.True(t, it.HasNext())
+	assert.True(t, l.IsEmpty())
+}
+
+func TestCopyOnWrite_Concurrent(t *testing.T) {
+	l := list.EmptyCopyOnWriteList[int]()
+
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := range 100 {
+				l.Add(i*100 + j)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				for range l.All() {
+				}
+				l.Size()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 800, l.Size())
+}
+
+func TestCopyOnWrite_SubListIsCopy(t *testing.T) {
+	l := list.NewCopyOnWriteList(0, 1, 2, 3, 4)
+	sub := l.SubList(1, 4)
+
+	l.Remove(0)
+	sub.Add(9)
+	assert.Equal(t, []int{1, 2, 3, 9}, sub.Elements())
+	assert.Equal(t, []int{1, 2, 3, 4}, l.Elements())
+	assert.Panics(t, func() { l.SubList(2, 5) })
+}
+
+func TestCopyOnWrite_SubListConcurrent(t *testing.T) {
+	l := list.EmptyCopyOnWriteList[int]()
+	l.Add(0, 1, 2, 3)
+
+	var wg sync.WaitGroup
+	for i := range 4 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := range 100 {
+				value := (i+1)*1000 + j
+				l.Insert(0, value)
+				l.RemoveElement(value)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				sub := l.SubList(1, 3)
+				first, _ := sub.Get(0)
+				l.Set(1, -1)
+
+				// Writes to the parent never reach a sub list taken earlier
+				assert.Equal(t, 2, sub.Size())
+				assert.Equal(t, first, sub.Elements()[0])
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 4, l.Size())
+}
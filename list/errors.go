@@ -0,0 +1,11 @@
+package list
+
+import (
+	"github.com/avila-r/ego"
+)
+
+var errors = ego.ExtendedGoErrorsNamespace.Class("list")
+
+var (
+	ErrIndexOutOfRange = errors.New("index out of range")
+)
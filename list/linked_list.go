@@ -0,0 +1,348 @@
+package list
+
+import (
+	"iter"
+	"slices"
+
+	"github.com/avila-r/ego/collection"
+	"github.com/avila-r/ego/function"
+	"github.com/avila-r/ego/iterator"
+	"github.com/avila-r/ego/stream"
+)
+
+type linkedNode[T any] struct {
+	value T
+	next  *linkedNode[T]
+	prev  *linkedNode[T]
+}
+
+// LinkedList is a doubly linked list. Operations at either end are O(1);
+// indexed access walks from the nearest end.
+type LinkedList[T comparable] struct {
+	head *linkedNode[T]
+	tail *linkedNode[T]
+	size int
+}
+
+var (
+	_ collection.List[int]  = (*LinkedList[int])(nil)
+	_ collection.Deque[int] = (*LinkedList[int])(nil)
+)
+
+func NewLinkedList[T comparable](items ...T) *LinkedList[T] {
+	l := &LinkedList[T]{}
+	l.Add(items...)
+	return l
+}
+
+func EmptyLinkedList[T comparable]() *LinkedList[T] {
+	return &LinkedList[T]{}
+}
+
+// node returns the node at index, which must be in range
+func (l *LinkedList[T]) node(index int) *linkedNode[T] {
+	if index < l.size/2 {
+		current := l.head
+		for range index {
+			current = current.next
+		}
+		return current
+	}
+
+	current := l.tail
+	for range l.size - 1 - index {
+		current = current.prev
+	}
+	return current
+}
+
+func (l *LinkedList[T]) unlink(node *linkedNode[T]) {
+	if node.prev == nil {
+		l.head = node.next
+	} else {
+		node.prev.next = node.next
+	}
+
+	if node.next == nil {
+		l.tail = node.prev
+	} else {
+		node.next.prev = node.prev
+	}
+
+	node.next, node.prev = nil, nil
+	l.size--
+}
+
+// removeRange unlinks the nodes in [from, to), walking to from only once
+func (l *LinkedList[T]) removeRange(from, to int) {
+	if to = min(to, l.size); from >= to {
+		return
+	}
+	current := l.node(from)
+	for range to - from {
+		next := current.next
+		l.unlink(current)
+		current = next
+	}
+}
+
+func (l *LinkedList[T]) Add(items ...T) {
+	for _, item := range items {
+		l.AddLast(item)
+	}
+}
+
+func (l *LinkedList[T]) AddFirst(item T) {
+	node := &linkedNode[T]{value: item, next: l.head}
+	if l.head == nil {
+		l.tail = node
+	} else {
+		l.head.prev = node
+	}
+	l.head = node
+	l.size++
+}
+
+func (l *LinkedList[T]) AddLast(item T) {
+	node := &linkedNode[T]{value: item, prev: l.tail}
+	if l.tail == nil {
+		l.head = node
+	} else {
+		l.tail.next = node
+	}
+	l.tail = node
+	l.size++
+}
+
+func (l *LinkedList[T]) Get(index int) (T, bool) {
+	var zero T
+	if index < 0 || index >= l.size {
+		return zero, false
+	}
+	return l.node(index).value, true
+}
+
+func (l *LinkedList[T]) Set(index int, value T) bool {
+	if index < 0 || index >= l.size {
+		return false
+	}
+	l.node(index).value = value
+	return true
+}
+
+func (l *LinkedList[T]) Remove(index int) bool {
+	if index < 0 || index >= l.size {
+		return false
+	}
+	l.unlink(l.node(index))
+	return true
+}
+
+func (l *LinkedList[T]) PollFirst() (T, bool) {
+	var zero T
+	if l.head == nil {
+		return zero, false
+	}
+	node := l.head
+	l.unlink(node)
+	return node.value, true
+}
+
+func (l *LinkedList[T]) PollLast() (T, bool) {
+	var zero T
+	if l.tail == nil {
+		return zero, false
+	}
+	node := l.tail
+	l.unlink(node)
+	return node.value, true
+}
+
+func (l *LinkedList[T]) PeekFirst() (T, bool) {
+	var zero T
+	if l.head == nil {
+		return zero, false
+	}
+	return l.head.value, true
+}
+
+func (l *LinkedList[T]) PeekLast() (T, bool) {
+	var zero T
+	if l.tail == nil {
+		return zero, false
+	}
+	return l.tail.value, true
+}
+
+func (l *LinkedList[T]) Insert(index int, items ...T) bool {
+	if index < 0 || index > l.size {
+		return false
+	}
+	if index == l.size {
+		l.Add(items...)
+		return true
+	}
+
+	at := l.node(index)
+	for _, item := range items {
+		node := &linkedNode[T]{value: item, prev: at.prev, next: at}
+		if at.prev == nil {
+			l.head = node
+		} else {
+			at.prev.next = node
+		}
+		at.prev = node
+		l.size++
+	}
+	return true
+}
+
+func (l *LinkedList[T]) IndexOf(value T) int {
+	index := 0
+	for current := l.head; current != nil; current = current.next {
+		if current.value == value {
+			return index
+		}
+		index++
+	}
+	return -1
+}
+
+func (l *LinkedList[T]) LastIndexOf(value T) int {
+	index := l.size - 1
+	for current := l.tail; current != nil; current = current.prev {
+		if current.value == value {
+			return index
+		}
+		index--
+	}
+	return -1
+}
+
+func (l *LinkedList[T]) SubList(from, to int) collection.List[T] {
+	return newSubList(l, from, to)
+}
+
+func (l *LinkedList[T]) Sort(comparator function.Comparator[T]) {
+	elements := l.Elements()
+	slices.SortStableFunc(elements, comparator.Compare)
+
+	current := l.head
+	for _, element := range elements {
+		current.value = element
+		current = current.next
+	}
+}
+
+// BinarySearch copies the list into a slice first, so it runs in O(n)
+func (l *LinkedList[T]) BinarySearch(value T, comparator function.Comparator[T]) (int, bool) {
+	return slices.BinarySearchFunc(l.Elements(), value, comparator.Compare)
+}
+
+func (l *LinkedList[T]) RemoveIf(predicate function.Predicate[T]) bool {
+	removed := false
+	for current := l.head; current != nil; {
+		next := current.next
+		if predicate.Test(current.value) {
+			l.unlink(current)
+			removed = true
+		}
+		current = next
+	}
+	return removed
+}
+
+func (l *LinkedList[T]) ReplaceAll(operator function.UnaryOperator[T]) {
+	for current := l.head; current != nil; current = current.next {
+		current.value = operator.Apply(current.value)
+	}
+}
+
+func (l *LinkedList[T]) RetainAll(items ...T) bool {
+	return l.RemoveIf(notIn(items))
+}
+
+func (l *LinkedList[T]) Equals(other collection.List[T]) bool {
+	return equal(l, other)
+}
+
+func (l *LinkedList[T]) Contains(value T) bool {
+	return l.IndexOf(value) >= 0
+}
+
+func (l *LinkedList[T]) Size() int {
+	return l.size
+}
+
+func (l *LinkedList[T]) IsEmpty() bool {
+	return l.size == 0
+}
+
+func (l *LinkedList[T]) Clear() {
+	l.head, l.tail, l.size = nil, nil, 0
+}
+
+func (l *LinkedList[T]) Elements() []T {
+	elements := make([]T, 0, l.size)
+	for current := l.head; current != nil; current = current.next {
+		elements = append(elements, current.value)
+	}
+	return elements
+}
+
+func (l *LinkedList[T]) Stream() stream.Stream[T] {
+	return stream.FromSeq(l.All())
+}
+
+func (l *LinkedList[T]) ForEach(action func(T)) {
+	for current := l.head; current != nil; current = current.next {
+		action(current.value)
+	}
+}
+
+func (l *LinkedList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for current := l.head; current != nil; current = current.next {
+			if !yield(current.value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns a sequence over the elements from last to first
+func (l *LinkedList[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for current := l.tail; current != nil; current = current.prev {
+			if !yield(current.value) {
+				return
+			}
+		}
+	}
+}
+
+func (l *LinkedList[T]) Iterator() iterator.Iterator[T] {
+	return l.walk(func() *linkedNode[T] { return l.head }, func(n *linkedNode[T]) *linkedNode[T] { return n.next })
+}
+
+// DescendingIterator returns an iterator over the elements from last to first
+func (l *LinkedList[T]) DescendingIterator() iterator.Iterator[T] {
+	return l.walk(func() *linkedNode[T] { return l.tail }, func(n *linkedNode[T]) *linkedNode[T] { return n.prev })
+}
+
+func (l *LinkedList[T]) walk(start func() *linkedNode[T], step func(*linkedNode[T]) *linkedNode[T]) iterator.Iterator[T] {
+	current := start()
+	next := func() (T, bool) {
+		if current == nil {
+			var zero T
+			return zero, false
+		}
+		value := current.value
+		current = step(current)
+		return value, true
+	}
+	reset := func() {
+		current = start()
+	}
+	return iterator.FromFunc(next, reset)
+}
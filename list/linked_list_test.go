@@ -0,0 +1,142 @@
+package list_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/avila-r/ego/list"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLinked_Add(t *testing.T) {
+	l := list.NewLinkedList(1, 2)
+	l.Add(3, 4)
+	l.AddFirst(0)
+
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, l.Elements())
+	assert.Equal(t, 5, l.Size())
+}
+
+func TestLinked_Get(t *testing.T) {
+	type Case struct {
+		name     string
+		index    int
+		expected int
+		success  bool
+	}
+
+	cases := []Case{
+		{"first element", 0, 10, true},
+		{"front half", 1, 20, true},
+		{"back half", 3, 40, true},
+		{"last element", 4, 50, true},
+		{"out of range positive", 5, 0, false},
+		{"out of range negative", -1, 0, false},
+	}
+
+	l := list.NewLinkedList(10, 20, 30, 40, 50)
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			val, ok := l.Get(c.index)
+			assert.Equal(t, c.success, ok)
+			assert.Equal(t, c.expected, val)
+		})
+	}
+}
+
+func TestLinked_Set(t *testing.T) {
+	l := list.NewLinkedList(1, 2, 3)
+
+	assert.True(t, l.Set(2, 30))
+	assert.False(t, l.Set(3, 40))
+	assert.Equal(t, []int{1, 2, 30}, l.Elements())
+}
+
+func TestLinked_Remove(t *testing.T) {
+	type Case struct {
+		name     string
+		index    int
+		expected []int
+		success  bool
+	}
+
+	cases := []Case{
+		{"remove head", 0, []int{2, 3}, true},
+		{"remove middle", 1, []int{1, 3}, true},
+		{"remove tail", 2, []int{1, 2}, true},
+		{"out of range", 3, []int{1, 2, 3}, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			l := list.NewLinkedList(1, 2, 3)
+			assert.Equal(t, c.success, l.Remove(c.index))
+			assert.Equal(t, c.expected, l.Elements())
+
+			backward := slices.Collect(l.Backward())
+			slices.Reverse(backward)
+			assert.Equal(t, c.expected, backward)
+		})
+	}
+}
+
+func TestLinked_Deque(t *testing.T) {
+	l := list.EmptyLinkedList[string]()
+
+	_, ok := l.PollFirst()
+	assert.False(t, ok)
+	_, ok = l.PeekLast()
+	assert.False(t, ok)
+
+	l.AddLast("b")
+	l.AddFirst("a")
+	l.AddLast("c")
+
+	first, _ := l.PeekFirst()
+	last, _ := l.PeekLast()
+	assert.Equal(t, "a", first)
+	assert.Equal(t, "c", last)
+
+	first, _ = l.PollFirst()
+	last, _ = l.PollLast()
+	assert.Equal(t, "a", first)
+	assert.Equal(t, "c", last)
+	assert.Equal(t, []string{"b"}, l.Elements())
+
+	l.PollLast()
+	assert.True(t, l.IsEmpty())
+	l.AddFirst("z")
+	assert.Equal(t, []string{"z"}, l.Elements())
+}
+
+func TestLinked_Contains(t *testing.T) {
+	l := list.NewLinkedList("a", "b")
+
+	assert.True(t, l.Contains("b"))
+	assert.False(t, l.Contains("c"))
+}
+
+func TestLinked_Clear(t *testing.T) {
+	l := list.NewLinkedList(1, 2, 3)
+	l.Clear()
+
+	assert.True(t, l.IsEmpty())
+	assert.Equal(t, []int{}, l.Elements())
+}
+
+func TestLinked_Iterator(t *testing.T) {
+	l := list.NewLinkedList(1, 2, 3)
+
+	assert.Equal(t, []int{1, 2, 3}, l.Iterator().Collect())
+
+	descending := l.DescendingIterator()
+	assert.Equal(t, 3, descending.Next())
+	descending.Reset()
+	assert.Equal(t, []int{3, 2, 1}, descending.Collect())
+}
+
+func TestLinked_Stream(t *testing.T) {
+	l := list.NewLinkedList(1, 2, 3, 4)
+
+	assert.Equal(t, []int{1, 2}, l.Stream().Limit(2).ToSlice())
+}
@@ -0,0 +1,269 @@
+package list_test
+
+import (
+	"testing"
+
+	"github.com/avila-r/ego/collection"
+	"github.com/avila-r/ego/function"
+	"github.com/avila-r/ego/list"
+	"github.com/stretchr/testify/assert"
+)
+
+var ascending = function.NewComparator(func(a, b int) int { return a - b })
+
+// implementations runs test against every collection.List in the package
+func implementations(t *testing.T, test func(t *testing.T, of func(...int) collection.List[int])) {
+	constructors := map[string]func(...int) collection.List[int]{
+		"array list":  func(items ...int) collection.List[int] { return list.NewArrayList(items...) },
+		"linked list": func(items ...int) collection.List[int] { return list.NewLinkedList(items...) },
+		"copy on write list": func(items ...int) collection.List[int] {
+			return list.NewCopyOnWriteList(items...)
+		},
+		"synchronized list": func(items ...int) collection.List[int] {
+			return collection.Synchronized[int](list.NewArrayList(items...))
+		},
+		"sub list": func(items ...int) collection.List[int] {
+			padded := append(append([]int{-1}, items...), -1)
+			return list.NewArrayList(padded...).SubList(1, len(items)+1)
+		},
+	}
+
+	for name, of := range constructors {
+		t.Run(name, func(t *testing.T) {
+			test(t, of)
+		})
+	}
+}
+
+func Test_Insert(t *testing.T) {
+	type Case struct {
+		name     string
+		index    int
+		toInsert []int
+		expected []int
+		success  bool
+	}
+
+	cases := []Case{
+		{"insert at head", 0, []int{8, 9}, []int{8, 9, 1, 2, 3}, true},
+		{"insert in middle", 1, []int{8}, []int{1, 8, 2, 3}, true},
+		{"insert at end", 3, []int{8}, []int{1, 2, 3, 8}, true},
+		{"out of range", 4, []int{8}, []int{1, 2, 3}, false},
+		{"negative index", -1, []int{8}, []int{1, 2, 3}, false},
+	}
+
+	implementations(t, func(t *testing.T, of func(...int) collection.List[int]) {
+		for _, c := range cases {
+			t.Run(c.name, func(t *testing.T) {
+				l := of(1, 2, 3)
+				assert.Equal(t, c.success, l.Insert(c.index, c.toInsert...))
+				assert.Equal(t, c.expected, l.Elements())
+				assert.Equal(t, len(c.expected), l.Size())
+			})
+		}
+	})
+}
+
+func Test_IndexOf(t *testing.T) {
+	implementations(t, func(t *testing.T, of func(...int) collection.List[int]) {
+		l := of(1, 2, 3, 2)
+
+		assert.Equal(t, 1, l.IndexOf(2))
+		assert.Equal(t, 3, l.LastIndexOf(2))
+		assert.Equal(t, -1, l.IndexOf(4))
+		assert.Equal(t, -1, l.LastIndexOf(4))
+	})
+}
+
+func Test_Sort(t *testing.T) {
+	implementations(t, func(t *testing.T, of func(...int) collection.List[int]) {
+		l := of(3, 1, 2)
+		l.Sort(ascending)
+
+		assert.Equal(t, []int{1, 2, 3}, l.Elements())
+	})
+}
+
+func Test_BinarySearch(t *testing.T) {
+	type Case struct {
+		name    string
+		value   int
+		index   int
+		success bool
+	}
+
+	cases := []Case{
+		{"present", 5, 2, true},
+		{"absent before start", 0, 0, false},
+		{"absent in middle", 4, 2, false},
+		{"absent past end", 10, 4, false},
+	}
+
+	implementations(t, func(t *testing.T, of func(...int) collection.List[int]) {
+		l := of(1, 3, 5, 7)
+		for _, c := range cases {
+			t.Run(c.name, func(t *testing.T) {
+				index, ok := l.BinarySearch(c.value, ascending)
+				assert.Equal(t, c.index, index)
+				assert.Equal(t, c.success, ok)
+			})
+		}
+	})
+}
+
+func Test_RemoveIf(t *testing.T) {
+	isEven := function.NewPredicate(func(v int) bool { return v%2 == 0 })
+
+	implementations(t, func(t *testing.T, of func(...int) collection.List[int]) {
+		l := of(1, 2, 3, 4, 6)
+
+		assert.True(t, l.RemoveIf(isEven))
+		assert.Equal(t, []int{1, 3}, l.Elements())
+		assert.False(t, l.RemoveIf(isEven))
+	})
+}
+
+func Test_ReplaceAll(t *testing.T) {
+	implementations(t, func(t *testing.T, of func(...int) collection.List[int]) {
+		l := of(1, 2, 3)
+		l.ReplaceAll(function.NewUnaryOperator(func(v int) int { return v * 10 }))
+
+		assert.Equal(t, []int{10, 20, 30}, l.Elements())
+	})
+}
+
+func Test_RetainAll(t *testing.T) {
+	implementations(t, func(t *testing.T, of func(...int) collection.List[int]) {
+		l := of(1, 2, 3, 2, 4)
+
+		assert.True(t, l.RetainAll(2, 4, 5))
+		assert.Equal(t, []int{2, 2, 4}, l.Elements())
+		assert.False(t, l.RetainAll(2, 4))
+	})
+}
+
+func Test_Equals(t *testing.T) {
+	implementations(t, func(t *testing.T, of func(...int) collection.List[int]) {
+		l := of(1, 2, 3)
+
+		assert.True(t, l.Equals(list.NewArrayList(1, 2, 3)))
+		assert.True(t, l.Equals(list.NewLinkedList(1, 2, 3)))
+		assert.False(t, l.Equals(list.NewArrayList(1, 2)))
+		assert.False(t, l.Equals(list.NewArrayList(1, 3, 2)))
+		assert.False(t, l.Equals(list.NewLinkedList(1, 3, 2)))
+		assert.False(t, l.Equals(nil))
+	})
+}
+
+func Test_SubList(t *testing.T) {
+	implementations(t, func(t *testing.T, of func(...int) collection.List[int]) {
+		l := of(0, 1, 2, 3, 4, 5)
+		if _, ok := l.(*list.CopyOnWriteList[int]); ok {
+			t.Skip("copy on write sub lists are copies, not views")
+		}
+		view := l.SubList(1, 4)
+
+		assert.Equal(t, []int{1, 2, 3}, view.Elements())
+
+		view.Set(0, 10)
+		assert.Equal(t, []int{0, 10, 2, 3, 4, 5}, l.Elements())
+
+		l.Set(3, 30)
+		value, _ := view.Get(2)
+		assert.Equal(t, 30, value)
+
+		view.Add(99)
+		assert.Equal(t, []int{0, 10, 2, 30, 99, 4, 5}, l.Elements())
+
+		view.Remove(1)
+		assert.Equal(t, []int{10, 30, 99}, view.Elements())
+		assert.Equal(t, []int{0, 10, 30, 99, 4, 5}, l.Elements())
+
+		view.SubList(1, 3).Clear()
+		assert.Equal(t, []int{10}, view.Elements())
+		assert.Equal(t, []int{0, 10, 4, 5}, l.Elements())
+	})
+}
+
+func Test_SubList_RemoveIf(t *testing.T) {
+	isEven := function.NewPredicate(func(v int) bool { return v%2 == 0 })
+
+	implementations(t, func(t *testing.T, of func(...int) collection.List[int]) {
+		l := of(0, 1, 2, 3, 4, 5, 6, 7)
+		if _, ok := l.(*list.CopyOnWriteList[int]); ok {
+			t.Skip("copy on write sub lists are copies, not views")
+		}
+		view := l.SubList(1, 7)
+		nested := view.SubList(1, 5)
+
+		assert.True(t, nested.RemoveIf(isEven))
+		assert.Equal(t, []int{3, 5}, nested.Elements())
+		assert.Equal(t, []int{1, 3, 5, 6}, view.Elements())
+		assert.Equal(t, []int{0, 1, 3, 5, 6, 7}, l.Elements())
+		assert.False(t, nested.RemoveIf(isEven))
+
+		view.Clear()
+		assert.True(t, view.IsEmpty())
+		assert.Equal(t, []int{0, 7}, l.Elements())
+	})
+}
+
+func Test_SubList_BinarySearch(t *testing.T) {
+	parents := map[string]collection.List[int]{
+		"array list":  list.NewArrayList(-5, 1, 3, 5, 7, 20),
+		"linked list": list.NewLinkedList(-5, 1, 3, 5, 7, 20),
+	}
+
+	for name, parent := range parents {
+		t.Run(name, func(t *testing.T) {
+			view := parent.SubList(1, 5)
+
+			index, ok := view.BinarySearch(7, ascending)
+			assert.Equal(t, 3, index)
+			assert.True(t, ok)
+
+			index, ok = view.BinarySearch(20, ascending)
+			assert.Equal(t, 4, index)
+			assert.False(t, ok)
+
+			index, ok = view.BinarySearch(-5, ascending)
+			assert.Equal(t, 0, index)
+			assert.False(t, ok)
+		})
+	}
+}
+
+func Test_SubList_Window(t *testing.T) {
+	implementations(t, func(t *testing.T, of func(...int) collection.List[int]) {
+		items := make([]int, 1000)
+		for i := range items {
+			items[i] = i
+		}
+		view := of(items...).SubList(990, 995)
+
+		assert.Equal(t, []int{990, 991, 992, 993, 994}, view.Elements())
+		assert.Equal(t, 2, view.IndexOf(992))
+		assert.False(t, view.Contains(995))
+		assert.Equal(t, []int{991, 992}, view.SubList(1, 3).Elements())
+
+		var seen []int
+		for v := range view.All() {
+			if v == 992 {
+				break
+			}
+			seen = append(seen, v)
+		}
+		assert.Equal(t, []int{990, 991}, seen)
+	})
+}
+
+func Test_SubList_OutOfRange(t *testing.T) {
+	implementations(t, func(t *testing.T, of func(...int) collection.List[int]) {
+		l := of(1, 2, 3)
+
+		assert.Panics(t, func() { l.SubList(-1, 2) })
+		assert.Panics(t, func() { l.SubList(0, 4) })
+		assert.Panics(t, func() { l.SubList(2, 1) })
+		assert.NotPanics(t, func() { l.SubList(3, 3) })
+	})
+}
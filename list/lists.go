@@ -0,0 +1,56 @@
+package list
+
+import (
+	"github.com/avila-r/ego/collection"
+	"github.com/avila-r/ego/function"
+)
+
+// equal reports whether both lists hold the same elements in the same order
+func equal[T comparable](l, other collection.List[T]) bool {
+	if other == nil || l.Size() != other.Size() {
+		return false
+	}
+
+	if indexed(l) && indexed(other) {
+		for i := range l.Size() {
+			element, ok := l.Get(i)
+			candidate, found := other.Get(i)
+			if !ok || !found || element != candidate {
+				return false
+			}
+		}
+		return true
+	}
+
+	candidates := other.Iterator()
+	for element := range l.All() {
+		if !candidates.HasNext() || element != candidates.Next() {
+			return false
+		}
+	}
+	return true
+}
+
+// indexed reports whether list reads an element by index in constant time
+func indexed[T comparable](list collection.List[T]) bool {
+	switch list := list.(type) {
+	case *ArrayList[T], *CopyOnWriteList[T]:
+		return true
+	case *subList[T]:
+		return indexed(list.parent)
+	}
+	return false
+}
+
+// notIn returns a predicate matching the elements that aren't among items
+func notIn[T comparable](items []T) function.Predicate[T] {
+	retained := make(map[T]struct{}, len(items))
+	for _, item := range items {
+		retained[item] = struct{}{}
+	}
+
+	return function.NewPredicate(func(element T) bool {
+		_, ok := retained[element]
+		return !ok
+	})
+}
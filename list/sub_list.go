@@ -0,0 +1,251 @@
+package list
+
+import (
+	"iter"
+	"slices"
+	"sort"
+
+	"github.com/avila-r/ego/collection"
+	"github.com/avila-r/ego/function"
+	"github.com/avila-r/ego/iterator"
+	"github.com/avila-r/ego/stream"
+)
+
+// subList is a live view of the range [from, from+size) of its parent. Every
+// read and write goes through the parent list.
+type subList[T comparable] struct {
+	parent collection.List[T]
+	from   int
+	size   int
+}
+
+var _ collection.List[int] = (*subList[int])(nil)
+
+func newSubList[T comparable](parent collection.List[T], from, to int) *subList[T] {
+	if from < 0 || to > parent.Size() || from > to {
+		ErrIndexOutOfRange.Panic()
+	}
+	return &subList[T]{
+		parent: parent,
+		from:   from,
+		size:   to - from,
+	}
+}
+
+func (l *subList[T]) Add(items ...T) {
+	l.parent.Insert(l.from+l.size, items...)
+	l.size += len(items)
+}
+
+func (l *subList[T]) Insert(index int, items ...T) bool {
+	if index < 0 || index > l.size {
+		return false
+	}
+	l.parent.Insert(l.from+index, items...)
+	l.size += len(items)
+	return true
+}
+
+func (l *subList[T]) Get(index int) (T, bool) {
+	var zero T
+	if index < 0 || index >= l.size {
+		return zero, false
+	}
+	return l.parent.Get(l.from + index)
+}
+
+func (l *subList[T]) Set(index int, value T) bool {
+	if index < 0 || index >= l.size {
+		return false
+	}
+	return l.parent.Set(l.from+index, value)
+}
+
+func (l *subList[T]) Remove(index int) bool {
+	if index < 0 || index >= l.size {
+		return false
+	}
+	l.parent.Remove(l.from + index)
+	l.size--
+	return true
+}
+
+func (l *subList[T]) Size() int {
+	return l.size
+}
+
+func (l *subList[T]) IsEmpty() bool {
+	return l.size == 0
+}
+
+func (l *subList[T]) Clear() {
+	removeRange(l.parent, l.from, l.from+l.size)
+	l.size = 0
+}
+
+func (l *subList[T]) Contains(value T) bool {
+	return l.IndexOf(value) >= 0
+}
+
+func (l *subList[T]) IndexOf(value T) int {
+	index := 0
+	for element := range l.All() {
+		if element == value {
+			return index
+		}
+		index++
+	}
+	return -1
+}
+
+func (l *subList[T]) LastIndexOf(value T) int {
+	last, index := -1, 0
+	for element := range l.All() {
+		if element == value {
+			last = index
+		}
+		index++
+	}
+	return last
+}
+
+func (l *subList[T]) SubList(from, to int) collection.List[T] {
+	return newSubList(l, from, to)
+}
+
+func (l *subList[T]) Sort(comparator function.Comparator[T]) {
+	elements := l.Elements()
+	slices.SortStableFunc(elements, comparator.Compare)
+	for i, element := range elements {
+		l.Set(i, element)
+	}
+}
+
+func (l *subList[T]) BinarySearch(value T, comparator function.Comparator[T]) (int, bool) {
+	if !indexed(l.parent) {
+		// One walk to copy the range beats a walk from the head per probe
+		return slices.BinarySearchFunc(l.Elements(), value, comparator.Compare)
+	}
+
+	index := sort.Search(l.size, func(i int) bool {
+		element, _ := l.Get(i)
+		return comparator.Compare(element, value) >= 0
+	})
+	if element, ok := l.Get(index); ok && comparator.Compare(element, value) == 0 {
+		return index, true
+	}
+	return index, false
+}
+
+func (l *subList[T]) RemoveIf(predicate function.Predicate[T]) bool {
+	kept := slices.DeleteFunc(l.Elements(), predicate.Test)
+	if len(kept) == l.size {
+		return false
+	}
+
+	// Rewrite the range once instead of removing matches one by one
+	removeRange(l.parent, l.from, l.from+l.size)
+	l.parent.Insert(l.from, kept...)
+	l.size = len(kept)
+	return true
+}
+
+func (l *subList[T]) ReplaceAll(operator function.UnaryOperator[T]) {
+	for i := range l.size {
+		element, _ := l.Get(i)
+		l.Set(i, operator.Apply(element))
+	}
+}
+
+func (l *subList[T]) RetainAll(items ...T) bool {
+	return l.RemoveIf(notIn(items))
+}
+
+func (l *subList[T]) Equals(other collection.List[T]) bool {
+	return equal(l, other)
+}
+
+func (l *subList[T]) Elements() []T {
+	elements := make([]T, 0, l.size)
+	for element := range l.All() {
+		elements = append(elements, element)
+	}
+	return elements
+}
+
+func (l *subList[T]) Stream() stream.Stream[T] {
+	return stream.From(l)
+}
+
+func (l *subList[T]) ForEach(action func(T)) {
+	for element := range l.All() {
+		action(element)
+	}
+}
+
+func (l *subList[T]) All() iter.Seq[T] {
+	return window(l.parent, l.from, l.from+l.size)
+}
+
+// window returns a sequence over the elements of list in [from, to), reading
+// the backing storage of known lists directly so that traversal doesn't
+// depend on how deep into the list the range starts
+func window[T comparable](list collection.List[T], from, to int) iter.Seq[T] {
+	switch parent := list.(type) {
+	case *ArrayList[T]:
+		return slices.Values(clamp(parent.elements, from, to))
+	case *subList[T]:
+		return window(parent.parent, parent.from+from, parent.from+to)
+	case *LinkedList[T]:
+		return func(yield func(T) bool) {
+			if from >= parent.size {
+				return
+			}
+			current := parent.node(from)
+			for i := from; i < to && current != nil; i++ {
+				if !yield(current.value) {
+					return
+				}
+				current = current.next
+			}
+		}
+	}
+
+	return func(yield func(T) bool) {
+		for i := from; i < to; i++ {
+			element, ok := list.Get(i)
+			if !ok || !yield(element) {
+				return
+			}
+		}
+	}
+}
+
+// removeRange deletes the elements of list in [from, to), in a single pass for
+// the lists that support it and one element at a time otherwise
+func removeRange[T comparable](list collection.List[T], from, to int) {
+	switch parent := list.(type) {
+	case *ArrayList[T]:
+		to = min(to, len(parent.elements))
+		parent.elements = slices.Delete(parent.elements, min(from, to), to)
+	case *subList[T]:
+		removeRange(parent.parent, parent.from+from, parent.from+to)
+		parent.size -= to - from
+	case *LinkedList[T]:
+		parent.removeRange(from, to)
+	default:
+		for range to - from {
+			list.Remove(from)
+		}
+	}
+}
+
+// clamp returns elements[from:to], trimmed to what the slice still holds
+func clamp[T any](elements []T, from, to int) []T {
+	to = min(to, len(elements))
+	return elements[min(from, to):to]
+}
+
+func (l *subList[T]) Iterator() iterator.Iterator[T] {
+	return iterator.From(l)
+}
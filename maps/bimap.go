@@ -0,0 +1,157 @@
+package maps
+
+import (
+	"iter"
+
+	"github.com/avila-r/ego/collection"
+	"github.com/avila-r/ego/iterator"
+)
+
+// BiMap is a one-to-one map: every value is bound to at most one key, so it
+// can be looked up in both directions
+type BiMap[K comparable, V comparable] struct {
+	forward  map[K]V
+	backward map[V]K
+	inverse  *BiMap[V, K]
+}
+
+var _ collection.ReadOnlyMap[string, int] = (*BiMap[string, int])(nil)
+
+func NewBiMap[K comparable, V comparable]() *BiMap[K, V] {
+	return &BiMap[K, V]{
+		forward:  make(map[K]V),
+		backward: make(map[V]K),
+	}
+}
+
+// Inverse returns a view of the map with keys and values swapped. Changes
+// through either side are visible in the other.
+func (m *BiMap[K, V]) Inverse() *BiMap[V, K] {
+	if m.inverse == nil {
+		m.inverse = &BiMap[V, K]{
+			forward:  m.backward,
+			backward: m.forward,
+			inverse:  m,
+		}
+	}
+	return m.inverse
+}
+
+// Put binds key to value, replacing key's previous value. It fails with
+// ErrDuplicateValue if value is already bound to another key.
+func (m *BiMap[K, V]) Put(key K, value V) error {
+	if bound, ok := m.backward[value]; ok && bound != key {
+		return ErrDuplicateValue
+	}
+	m.bind(key, value)
+	return nil
+}
+
+// ForcePut binds key to value, dropping whichever key value was bound to
+func (m *BiMap[K, V]) ForcePut(key K, value V) {
+	if bound, ok := m.backward[value]; ok {
+		delete(m.forward, bound)
+	}
+	m.bind(key, value)
+}
+
+func (m *BiMap[K, V]) bind(key K, value V) {
+	if old, ok := m.forward[key]; ok {
+		delete(m.backward, old)
+	}
+	m.forward[key] = value
+	m.backward[value] = key
+}
+
+func (m *BiMap[K, V]) Get(key K) (V, bool) {
+	value, ok := m.forward[key]
+	return value, ok
+}
+
+func (m *BiMap[K, V]) GetOrDefault(key K, fallback V) V {
+	if value, ok := m.forward[key]; ok {
+		return value
+	}
+	return fallback
+}
+
+// Delete drops key and its value, reporting whether key was present
+func (m *BiMap[K, V]) Delete(key K) bool {
+	value, ok := m.forward[key]
+	if ok {
+		delete(m.forward, key)
+		delete(m.backward, value)
+	}
+	return ok
+}
+
+func (m *BiMap[K, V]) ContainsKey(key K) bool {
+	_, ok := m.forward[key]
+	return ok
+}
+
+func (m *BiMap[K, V]) ContainsValue(value V) bool {
+	_, ok := m.backward[value]
+	return ok
+}
+
+func (m *BiMap[K, V]) Len() int {
+	return len(m.forward)
+}
+
+func (m *BiMap[K, V]) IsEmpty() bool {
+	return len(m.forward) == 0
+}
+
+func (m *BiMap[K, V]) Clear() {
+	clear(m.forward)
+	clear(m.backward)
+}
+
+func (m *BiMap[K, V]) KeySlice() []K {
+	keys := make([]K, 0, len(m.forward))
+	for k := range m.forward {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func (m *BiMap[K, V]) ValueSlice() []V {
+	values := make([]V, 0, len(m.forward))
+	for _, v := range m.forward {
+		values = append(values, v)
+	}
+	return values
+}
+
+func (m *BiMap[K, V]) ToSlice() []collection.Entry[K, V] {
+	entries := make([]collection.Entry[K, V], 0, len(m.forward))
+	for k, v := range m.forward {
+		entries = append(entries, collection.Entry[K, V]{Key: k, Value: v})
+	}
+	return entries
+}
+
+func (m *BiMap[K, V]) Elements() map[K]V {
+	return Clone(m.forward)
+}
+
+func (m *BiMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range m.forward {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+func (m *BiMap[K, V]) ForEach(action func(K, V)) {
+	for k, v := range m.forward {
+		action(k, v)
+	}
+}
+
+func (m *BiMap[K, V]) Iterator() iterator.Iterator[collection.Entry[K, V]] {
+	return iterator.FromSeq(entries(m.All()))
+}
@@ -0,0 +1,86 @@
+package maps_test
+
+import (
+	"testing"
+
+	"github.com/avila-r/ego/maps"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_BiMap_Put(t *testing.T) {
+	type Case struct {
+		name     string
+		key      int
+		value    string
+		err      error
+		expected map[int]string
+	}
+
+	cases := []Case{
+		{"new pair", 3, "three", nil, map[int]string{1: "one", 2: "two", 3: "three"}},
+		{"same pair", 1, "one", nil, map[int]string{1: "one", 2: "two"}},
+		{"new value for key", 1, "uno", nil, map[int]string{1: "uno", 2: "two"}},
+		{"value bound elsewhere", 3, "one", maps.ErrDuplicateValue, map[int]string{1: "one", 2: "two"}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			m := maps.NewBiMap[int, string]()
+			m.Put(1, "one")
+			m.Put(2, "two")
+
+			assert.Equal(t, c.err, m.Put(c.key, c.value))
+			assert.Equal(t, c.expected, m.Elements())
+			assert.Equal(t, len(c.expected), m.Inverse().Len())
+			for k, v := range c.expected {
+				key, _ := m.Inverse().Get(v)
+				assert.Equal(t, k, key)
+			}
+		})
+	}
+}
+
+func Test_BiMap_ForcePut(t *testing.T) {
+	m := maps.NewBiMap[int, string]()
+	m.Put(1, "one")
+	m.Put(2, "two")
+
+	m.ForcePut(3, "one")
+
+	assert.Equal(t, map[int]string{2: "two", 3: "one"}, m.Elements())
+	assert.Equal(t, map[string]int{"two": 2, "one": 3}, m.Inverse().Elements())
+}
+
+func Test_BiMap_Inverse_IsView(t *testing.T) {
+	m := maps.NewBiMap[int, string]()
+	inverse := m.Inverse()
+
+	inverse.Put("one", 1)
+	assert.Equal(t, "one", m.GetOrDefault(1, ""))
+
+	assert.True(t, m.Delete(1))
+	assert.False(t, inverse.ContainsKey("one"))
+	assert.Same(t, m, inverse.Inverse())
+
+	assert.NoError(t, m.Put(2, "two"))
+	assert.ErrorIs(t, inverse.Put("deux", 2), maps.ErrDuplicateValue)
+	assert.True(t, m.ContainsValue("two"))
+}
+
+func Test_BiMap_Iteration(t *testing.T) {
+	m := maps.NewBiMap[string, int]()
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	assert.ElementsMatch(t, []string{"a", "b"}, m.KeySlice())
+	assert.ElementsMatch(t, []int{1, 2}, m.ValueSlice())
+	assert.ElementsMatch(t, m.ToSlice(), m.Iterator().Collect())
+
+	sum := 0
+	m.ForEach(func(_ string, v int) { sum += v })
+	assert.Equal(t, 3, sum)
+
+	m.Clear()
+	assert.True(t, m.IsEmpty())
+	assert.True(t, m.Inverse().IsEmpty())
+}
@@ -0,0 +1,203 @@
+package maps
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"reflect"
+	"strconv"
+
+	"github.com/avila-r/ego/collection"
+)
+
+// MarshalJSON encodes the map as a JSON object. Keys follow the rules of
+// encoding/json: strings, integers and encoding.TextMarshaler.
+func (m *HashMap[K, V]) MarshalJSON() ([]byte, error) {
+	if m.elements == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(m.elements)
+}
+
+// UnmarshalJSON replaces the map's contents with a JSON object
+func (m *HashMap[K, V]) UnmarshalJSON(data []byte) error {
+	elements := make(map[K]V)
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+	if elements == nil {
+		elements = make(map[K]V)
+	}
+	m.elements = elements
+	return nil
+}
+
+// MarshalBinary encodes the map with encoding/gob
+func (m *HashMap[K, V]) MarshalBinary() ([]byte, error) {
+	return encodeGob(m.elements)
+}
+
+// UnmarshalBinary replaces the map's contents with gob-encoded data
+func (m *HashMap[K, V]) UnmarshalBinary(data []byte) error {
+	elements := make(map[K]V)
+	if err := decodeGob(data, &elements); err != nil {
+		return err
+	}
+	m.elements = elements
+	return nil
+}
+
+// MarshalJSON encodes the map as a JSON object whose members follow the
+// map's insertion order
+func (m *LinkedHashMap[K, V]) MarshalJSON() ([]byte, error) {
+	var buffer bytes.Buffer
+	buffer.WriteByte('{')
+	for current := m.head; current != nil; current = current.next {
+		if current != m.head {
+			buffer.WriteByte(',')
+		}
+
+		key, err := encodeKey(current.key)
+		if err != nil {
+			return nil, err
+		}
+		encodedKey, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(current.value)
+		if err != nil {
+			return nil, err
+		}
+
+		buffer.Write(encodedKey)
+		buffer.WriteByte(':')
+		buffer.Write(value)
+	}
+	buffer.WriteByte('}')
+	return buffer.Bytes(), nil
+}
+
+// UnmarshalJSON replaces the map's contents with a JSON object, keeping its
+// members in document order
+func (m *LinkedHashMap[K, V]) UnmarshalJSON(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token == nil {
+		return nil
+	}
+	if token != json.Delim('{') {
+		return ErrNotAnObject
+	}
+
+	decoded := NewLinkedHashMap[K, V]()
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+		key, err := decodeKey[K](token.(string))
+		if err != nil {
+			return err
+		}
+
+		var value V
+		if err := decoder.Decode(&value); err != nil {
+			return err
+		}
+		decoded.Put(key, value)
+	}
+	if _, err := decoder.Token(); err != nil {
+		return err
+	}
+
+	*m = *decoded
+	return nil
+}
+
+// MarshalBinary encodes the map's entries, in insertion order, with
+// encoding/gob
+func (m *LinkedHashMap[K, V]) MarshalBinary() ([]byte, error) {
+	return encodeGob(m.ToSlice())
+}
+
+// UnmarshalBinary replaces the map's contents with gob-encoded data
+func (m *LinkedHashMap[K, V]) UnmarshalBinary(data []byte) error {
+	var entries []collection.Entry[K, V]
+	if err := decodeGob(data, &entries); err != nil {
+		return err
+	}
+
+	decoded := NewLinkedHashMap[K, V]()
+	for _, entry := range entries {
+		decoded.Put(entry.Key, entry.Value)
+	}
+	*m = *decoded
+	return nil
+}
+
+func encodeGob(value any) ([]byte, error) {
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(value); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+func decodeGob(data []byte, target any) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(target)
+}
+
+// encodeKey turns key into a JSON object key the way encoding/json does
+func encodeKey[K comparable](key K) (string, error) {
+	value := reflect.ValueOf(key)
+	if value.Kind() == reflect.String {
+		return value.String(), nil
+	}
+	if marshaler, ok := any(key).(encoding.TextMarshaler); ok {
+		text, err := marshaler.MarshalText()
+		return string(text), err
+	}
+
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(value.Uint(), 10), nil
+	}
+	return "", ErrUnsupportedKey
+}
+
+// decodeKey parses a JSON object key the way encoding/json does
+func decodeKey[K comparable](text string) (K, error) {
+	var key K
+	if unmarshaler, ok := any(&key).(encoding.TextUnmarshaler); ok {
+		err := unmarshaler.UnmarshalText([]byte(text))
+		return key, err
+	}
+
+	value := reflect.ValueOf(&key).Elem()
+	switch value.Kind() {
+	case reflect.String:
+		value.SetString(text)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(text, 10, value.Type().Bits())
+		if err != nil {
+			return key, err
+		}
+		value.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(text, 10, value.Type().Bits())
+		if err != nil {
+			return key, err
+		}
+		value.SetUint(n)
+	default:
+		return key, ErrUnsupportedKey
+	}
+	return key, nil
+}
@@ -0,0 +1,146 @@
+package maps_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/avila-r/ego/maps"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_HashMap_JSON(t *testing.T) {
+	m := maps.NewHashMap[string, int]()
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	encoded, err := json.Marshal(m)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"a":1,"b":2}`, string(encoded))
+
+	decoded := maps.NewHashMap[string, int]()
+	decoded.Put("stale", 0)
+	assert.NoError(t, json.Unmarshal(encoded, decoded))
+	assert.Equal(t, m.Elements(), decoded.Elements())
+
+	zero, err := json.Marshal(&maps.HashMap[string, int]{})
+	assert.NoError(t, err)
+	assert.Equal(t, `{}`, string(zero))
+}
+
+func Test_LinkedHashMap_JSON_KeepsOrder(t *testing.T) {
+	m := maps.NewLinkedHashMap[string, int]()
+	for _, key := range []string{"zulu", "alpha", "mike"} {
+		m.Put(key, len(key))
+	}
+
+	encoded, err := json.Marshal(m)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"zulu":4,"alpha":5,"mike":4}`, string(encoded))
+
+	decoded := maps.NewLinkedHashMap[string, int]()
+	assert.NoError(t, json.Unmarshal([]byte(`{"b":2,"c":3,"a":1}`), decoded))
+	assert.Equal(t, []string{"b", "c", "a"}, decoded.KeySlice())
+	assert.Equal(t, []int{2, 3, 1}, decoded.ValueSlice())
+}
+
+type celsius int
+
+func (c celsius) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("%dC", int(c))), nil
+}
+
+func (c *celsius) UnmarshalText(text []byte) error {
+	_, err := fmt.Sscanf(string(text), "%dC", (*int)(c))
+	return err
+}
+
+func Test_LinkedHashMap_JSON_Keys(t *testing.T) {
+	ints := maps.NewLinkedHashMap[int, string]()
+	ints.Put(10, "ten")
+	ints.Put(-1, "minus one")
+
+	encoded, err := json.Marshal(ints)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"10":"ten","-1":"minus one"}`, string(encoded))
+
+	decoded := maps.NewLinkedHashMap[int, string]()
+	assert.NoError(t, json.Unmarshal(encoded, decoded))
+	assert.Equal(t, []int{10, -1}, decoded.KeySlice())
+
+	texts := maps.NewLinkedHashMap[celsius, bool]()
+	texts.Put(21, true)
+	encoded, err = json.Marshal(texts)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"21C":true}`, string(encoded))
+
+	decodedTexts := maps.NewLinkedHashMap[celsius, bool]()
+	assert.NoError(t, json.Unmarshal(encoded, decodedTexts))
+	assert.Equal(t, []celsius{21}, decodedTexts.KeySlice())
+
+	floats := maps.NewLinkedHashMap[float64, int]()
+	floats.Put(1.5, 1)
+	_, err = json.Marshal(floats)
+	assert.ErrorIs(t, err, maps.ErrUnsupportedKey)
+}
+
+func Test_LinkedHashMap_JSON_Invalid(t *testing.T) {
+	type Case struct {
+		name  string
+		input string
+	}
+
+	cases := []Case{
+		{"array", `[1,2]`},
+		{"bad key", `{"x":1}`},
+		{"bad value", `{"1":"one"}`},
+		{"truncated", `{"1":1`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			m := maps.NewLinkedHashMap[int, int]()
+			m.Put(7, 7)
+			assert.Error(t, json.Unmarshal([]byte(c.input), m))
+			assert.Equal(t, []int{7}, m.KeySlice())
+		})
+	}
+}
+
+func Test_LinkedHashMap_JSON_InStruct(t *testing.T) {
+	type DTO struct {
+		Headers *maps.LinkedHashMap[string, string] `json:"headers"`
+	}
+
+	var dto DTO
+	assert.NoError(t, json.Unmarshal([]byte(`{"headers":{"X-B":"2","X-A":"1"}}`), &dto))
+	assert.Equal(t, []string{"X-B", "X-A"}, dto.Headers.KeySlice())
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"headers":null}`), &dto))
+	assert.Nil(t, dto.Headers)
+}
+
+func Test_Maps_Gob(t *testing.T) {
+	hash := maps.NewHashMap[string, int]()
+	hash.Put("a", 1)
+	linked := maps.NewLinkedHashMap[string, int]()
+	for i, key := range []string{"c", "a", "b"} {
+		linked.Put(key, i)
+	}
+
+	var buffer bytes.Buffer
+	encoder := gob.NewEncoder(&buffer)
+	assert.NoError(t, encoder.Encode(hash))
+	assert.NoError(t, encoder.Encode(linked))
+
+	decoder := gob.NewDecoder(&buffer)
+	decodedHash := maps.NewHashMap[string, int]()
+	decodedLinked := maps.NewLinkedHashMap[string, int]()
+	assert.NoError(t, decoder.Decode(decodedHash))
+	assert.NoError(t, decoder.Decode(decodedLinked))
+
+	assert.Equal(t, hash.Elements(), decodedHash.Elements())
+	assert.Equal(t, linked.ToSlice(), decodedLinked.ToSlice())
+}
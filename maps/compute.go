@@ -0,0 +1,69 @@
+package maps
+
+import (
+	"iter"
+)
+
+// The helpers below implement the collection.Map update operations on top of
+// Get, Put and Delete for the maps that aren't safe for concurrent use.
+
+type updatable[K any, V any] interface {
+	Get(key K) (V, bool)
+	Put(key K, value V)
+	Delete(key K)
+}
+
+func getOrDefault[K any, V any](m updatable[K, V], key K, fallback V) V {
+	if value, ok := m.Get(key); ok {
+		return value
+	}
+	return fallback
+}
+
+func compute[K any, V any](m updatable[K, V], key K, remapping func(K, V, bool) (V, bool)) (V, bool) {
+	current, exists := m.Get(key)
+	value, keep := remapping(key, current, exists)
+	if !keep {
+		if exists {
+			m.Delete(key)
+		}
+		var zero V
+		return zero, false
+	}
+	m.Put(key, value)
+	return value, true
+}
+
+func computeIfAbsent[K any, V any](m updatable[K, V], key K, mapping func(K) V) V {
+	if value, ok := m.Get(key); ok {
+		return value
+	}
+	value := mapping(key)
+	m.Put(key, value)
+	return value
+}
+
+func computeIfPresent[K any, V any](m updatable[K, V], key K, remapping func(K, V) (V, bool)) (V, bool) {
+	if _, ok := m.Get(key); !ok {
+		var zero V
+		return zero, false
+	}
+	return compute(m, key, func(key K, current V, _ bool) (V, bool) {
+		return remapping(key, current)
+	})
+}
+
+func merge[K any, V any](m updatable[K, V], key K, value V, remapping func(V, V) (V, bool)) (V, bool) {
+	return compute(m, key, func(_ K, current V, present bool) (V, bool) {
+		if !present {
+			return value, true
+		}
+		return remapping(current, value)
+	})
+}
+
+func putAll[K any, V any](m updatable[K, V], other interface{ All() iter.Seq2[K, V] }) {
+	for k, v := range other.All() {
+		m.Put(k, v)
+	}
+}
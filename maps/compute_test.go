@@ -0,0 +1,166 @@
+package maps_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/avila-r/ego/collection"
+	"github.com/avila-r/ego/maps"
+	"github.com/stretchr/testify/assert"
+)
+
+// implementations runs test against every collection.Map in the package
+func implementations(t *testing.T, test func(t *testing.T, m collection.Map[string, int])) {
+	constructors := map[string]func() collection.Map[string, int]{
+		"hash map":            func() collection.Map[string, int] { return maps.NewHashMap[string, int]() },
+		"linked hash map":     func() collection.Map[string, int] { return maps.NewLinkedHashMap[string, int]() },
+		"tree map":            func() collection.Map[string, int] { return maps.NewOrderedTreeMap[string, int]() },
+		"concurrent hash map": func() collection.Map[string, int] { return maps.NewConcurrentHashMap[string, int]() },
+	}
+
+	for name, constructor := range constructors {
+		t.Run(name, func(t *testing.T) {
+			test(t, constructor())
+		})
+	}
+}
+
+func Test_GetOrDefault(t *testing.T) {
+	implementations(t, func(t *testing.T, m collection.Map[string, int]) {
+		m.Put("a", 1)
+
+		assert.Equal(t, 1, m.GetOrDefault("a", 9))
+		assert.Equal(t, 9, m.GetOrDefault("b", 9))
+	})
+}
+
+func Test_Compute(t *testing.T) {
+	implementations(t, func(t *testing.T, m collection.Map[string, int]) {
+		count := func(_ string, value int, _ bool) (int, bool) { return value + 1, true }
+
+		m.Compute("a", count)
+		value, ok := m.Compute("a", count)
+		assert.True(t, ok)
+		assert.Equal(t, 2, value)
+
+		_, ok = m.Compute("a", func(_ string, _ int, present bool) (int, bool) {
+			assert.True(t, present)
+			return 0, false
+		})
+		assert.False(t, ok)
+		assert.False(t, m.ContainsKey("a"))
+
+		_, ok = m.Compute("b", func(string, int, bool) (int, bool) { return 0, false })
+		assert.False(t, ok)
+		assert.True(t, m.IsEmpty())
+	})
+}
+
+func Test_ComputeIfAbsent(t *testing.T) {
+	implementations(t, func(t *testing.T, m collection.Map[string, int]) {
+		calls := 0
+		length := func(key string) int {
+			calls++
+			return len(key)
+		}
+
+		assert.Equal(t, 3, m.ComputeIfAbsent("abc", length))
+		assert.Equal(t, 3, m.ComputeIfAbsent("abc", length))
+		assert.Equal(t, 1, calls)
+	})
+}
+
+func Test_ComputeIfPresent(t *testing.T) {
+	implementations(t, func(t *testing.T, m collection.Map[string, int]) {
+		double := func(_ string, value int) (int, bool) { return value * 2, true }
+		m.Put("a", 2)
+
+		value, ok := m.ComputeIfPresent("a", double)
+		assert.True(t, ok)
+		assert.Equal(t, 4, value)
+
+		_, ok = m.ComputeIfPresent("b", double)
+		assert.False(t, ok)
+		assert.False(t, m.ContainsKey("b"))
+
+		m.ComputeIfPresent("a", func(string, int) (int, bool) { return 0, false })
+		assert.False(t, m.ContainsKey("a"))
+	})
+}
+
+func Test_Merge(t *testing.T) {
+	implementations(t, func(t *testing.T, m collection.Map[string, int]) {
+		sum := func(old, value int) (int, bool) { return old + value, true }
+
+		for _, word := range strings.Fields("a b a c a b") {
+			m.Merge(word, 1, sum)
+		}
+
+		assert.Equal(t, map[string]int{"a": 3, "b": 2, "c": 1}, m.Elements())
+
+		m.Merge("a", 0, func(int, int) (int, bool) { return 0, false })
+		assert.False(t, m.ContainsKey("a"))
+	})
+}
+
+func Test_ReplaceAll(t *testing.T) {
+	implementations(t, func(t *testing.T, m collection.Map[string, int]) {
+		m.Put("a", 1)
+		m.Put("bb", 2)
+
+		m.ReplaceAll(func(k string, v int) int { return len(k) * 10 * v })
+
+		assert.Equal(t, map[string]int{"a": 10, "bb": 40}, m.Elements())
+	})
+}
+
+func Test_PutAll(t *testing.T) {
+	implementations(t, func(t *testing.T, m collection.Map[string, int]) {
+		m.Put("a", 1)
+		other := maps.NewLinkedHashMap[string, int]()
+		other.Put("a", 10)
+		other.Put("b", 20)
+
+		m.PutAll(other)
+
+		assert.Equal(t, map[string]int{"a": 10, "b": 20}, m.Elements())
+	})
+}
+
+func Test_RemoveIf(t *testing.T) {
+	implementations(t, func(t *testing.T, m collection.Map[string, int]) {
+		for i, key := range []string{"a", "b", "c", "d"} {
+			m.Put(key, i)
+		}
+		odd := func(_ string, v int) bool { return v%2 == 1 }
+
+		assert.True(t, m.RemoveIf(odd))
+		assert.Equal(t, map[string]int{"a": 0, "c": 2}, m.Elements())
+		assert.False(t, m.RemoveIf(odd))
+	})
+}
+
+func Test_ForEach(t *testing.T) {
+	implementations(t, func(t *testing.T, m collection.Map[string, int]) {
+		m.Put("a", 1)
+		m.Put("b", 2)
+
+		visited := map[string]int{}
+		m.ForEach(func(k string, v int) { visited[k] = v })
+
+		assert.Equal(t, map[string]int{"a": 1, "b": 2}, visited)
+	})
+}
+
+func TestLinked_ForEach_KeepsOrder(t *testing.T) {
+	m := maps.NewLinkedHashMap[string, int]()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.RemoveIf(func(k string, _ int) bool { return k == "a" })
+
+	keys := []string{}
+	m.ForEach(func(k string, _ int) { keys = append(keys, k) })
+
+	assert.Equal(t, []string{"c", "b"}, keys)
+}
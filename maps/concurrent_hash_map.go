@@ -0,0 +1,340 @@
+package maps
+
+import (
+	"hash/maphash"
+	"iter"
+	"reflect"
+	"runtime"
+	"sync"
+
+	"github.com/avila-r/ego/collection"
+	"github.com/avila-r/ego/iterator"
+)
+
+type shard[K comparable, V any] struct {
+	mu       sync.RWMutex
+	elements map[K]V
+}
+
+// ConcurrentHashMap is a hash map safe for concurrent use. Keys are spread
+// over independently locked shards, so goroutines working on different keys
+// rarely contend.
+//
+// Single-key operations, including Compute and friends, are atomic. Bulk
+// reads such as Len, All and ToSlice are weakly consistent: they visit one
+// shard at a time and may or may not reflect updates made while they run.
+type ConcurrentHashMap[K comparable, V any] struct {
+	shards []*shard[K, V]
+	seed   maphash.Seed
+}
+
+var _ collection.Map[string, int] = (*ConcurrentHashMap[string, int])(nil)
+
+// NewConcurrentHashMap creates an empty map with a shard count derived from
+// GOMAXPROCS
+func NewConcurrentHashMap[K comparable, V any]() *ConcurrentHashMap[K, V] {
+	return NewShardedConcurrentHashMap[K, V](4 * runtime.GOMAXPROCS(0))
+}
+
+// NewShardedConcurrentHashMap creates an empty map with at least shards
+// shards, rounded up to a power of two
+func NewShardedConcurrentHashMap[K comparable, V any](shards int) *ConcurrentHashMap[K, V] {
+	count := 1
+	for count < shards {
+		count <<= 1
+	}
+
+	m := &ConcurrentHashMap[K, V]{
+		shards: make([]*shard[K, V], count),
+		seed:   maphash.MakeSeed(),
+	}
+	for i := range m.shards {
+		m.shards[i] = &shard[K, V]{elements: make(map[K]V)}
+	}
+	return m
+}
+
+func (m *ConcurrentHashMap[K, V]) shard(key K) *shard[K, V] {
+	return m.shards[maphash.Comparable(m.seed, key)&uint64(len(m.shards)-1)]
+}
+
+func (m *ConcurrentHashMap[K, V]) Get(key K) (V, bool) {
+	s := m.shard(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	value, ok := s.elements[key]
+	return value, ok
+}
+
+func (m *ConcurrentHashMap[K, V]) Put(key K, value V) {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.elements[key] = value
+}
+
+func (m *ConcurrentHashMap[K, V]) PutIfAbsent(key K, value V) bool {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.elements[key]; exists {
+		return false
+	}
+	s.elements[key] = value
+	return true
+}
+
+func (m *ConcurrentHashMap[K, V]) Delete(key K) {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.elements, key)
+}
+
+// CompareAndSwap replaces the value for key with new if the current value
+// deeply equals old
+func (m *ConcurrentHashMap[K, V]) CompareAndSwap(key K, old, new V) bool {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current, exists := s.elements[key]
+	if !exists || !reflect.DeepEqual(current, old) {
+		return false
+	}
+	s.elements[key] = new
+	return true
+}
+
+// Compute atomically replaces the entry for key with the result of
+// remapping, which receives the current value and whether it exists.
+// Returning false from remapping removes the entry. It returns the new value
+// and whether the key is now present.
+//
+// remapping runs under the shard lock and must not call back into the map.
+func (m *ConcurrentHashMap[K, V]) Compute(key K, remapping func(key K, value V, present bool) (V, bool)) (V, bool) {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current, exists := s.elements[key]
+	value, keep := remapping(key, current, exists)
+	if !keep {
+		delete(s.elements, key)
+		var zero V
+		return zero, false
+	}
+	s.elements[key] = value
+	return value, true
+}
+
+// ComputeIfAbsent returns the value for key, atomically storing the result
+// of mapping first if the key is absent
+func (m *ConcurrentHashMap[K, V]) ComputeIfAbsent(key K, mapping func(key K) V) V {
+	if value, ok := m.Get(key); ok {
+		return value
+	}
+
+	value, _ := m.Compute(key, func(key K, current V, present bool) (V, bool) {
+		if present {
+			return current, true
+		}
+		return mapping(key), true
+	})
+	return value
+}
+
+// ComputeIfPresent atomically replaces the value for key with the result of
+// remapping if the key is present. Returning false from remapping removes
+// the entry.
+func (m *ConcurrentHashMap[K, V]) ComputeIfPresent(key K, remapping func(key K, value V) (V, bool)) (V, bool) {
+	return m.Compute(key, func(key K, current V, present bool) (V, bool) {
+		if !present {
+			return current, false
+		}
+		return remapping(key, current)
+	})
+}
+
+// Merge atomically stores value for an absent key, or the result of
+// remapping the current value with value otherwise. Returning false from
+// remapping removes the entry.
+func (m *ConcurrentHashMap[K, V]) Merge(key K, value V, remapping func(old, value V) (V, bool)) (V, bool) {
+	return m.Compute(key, func(_ K, current V, present bool) (V, bool) {
+		if !present {
+			return value, true
+		}
+		return remapping(current, value)
+	})
+}
+
+func (m *ConcurrentHashMap[K, V]) GetOrDefault(key K, fallback V) V {
+	return getOrDefault(m, key, fallback)
+}
+
+// ReplaceAll replaces every value with the result of function, one shard at
+// a time. function runs under the shard lock and must not call back into the
+// map.
+func (m *ConcurrentHashMap[K, V]) ReplaceAll(function func(K, V) V) {
+	for _, s := range m.shards {
+		s.mu.Lock()
+		for k, v := range s.elements {
+			s.elements[k] = function(k, v)
+		}
+		s.mu.Unlock()
+	}
+}
+
+func (m *ConcurrentHashMap[K, V]) PutAll(other collection.Map[K, V]) {
+	putAll(m, other)
+}
+
+// RemoveIf removes every entry matching predicate, one shard at a time.
+// predicate runs under the shard lock and must not call back into the map.
+func (m *ConcurrentHashMap[K, V]) RemoveIf(predicate func(K, V) bool) bool {
+	removed := false
+	for _, s := range m.shards {
+		s.mu.Lock()
+		size := len(s.elements)
+		DeleteIf(s.elements, predicate)
+		removed = removed || len(s.elements) != size
+		s.mu.Unlock()
+	}
+	return removed
+}
+
+// ForEach calls action for every entry of a weakly consistent snapshot
+func (m *ConcurrentHashMap[K, V]) ForEach(action func(K, V)) {
+	for k, v := range m.All() {
+		action(k, v)
+	}
+}
+
+func (m *ConcurrentHashMap[K, V]) Clear() {
+	for _, s := range m.shards {
+		s.mu.Lock()
+		clear(s.elements)
+		s.mu.Unlock()
+	}
+}
+
+func (m *ConcurrentHashMap[K, V]) Len() int {
+	size := 0
+	for _, s := range m.shards {
+		s.mu.RLock()
+		size += len(s.elements)
+		s.mu.RUnlock()
+	}
+	return size
+}
+
+func (m *ConcurrentHashMap[K, V]) IsEmpty() bool {
+	for _, s := range m.shards {
+		s.mu.RLock()
+		size := len(s.elements)
+		s.mu.RUnlock()
+		if size > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *ConcurrentHashMap[K, V]) ContainsKey(key K) bool {
+	_, ok := m.Get(key)
+	return ok
+}
+
+func (m *ConcurrentHashMap[K, V]) ContainsValue(value V) bool {
+	for _, v := range m.All() {
+		if reflect.DeepEqual(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *ConcurrentHashMap[K, V]) Filter(predicate func(K, V) bool) collection.Map[K, V] {
+	filtered := NewShardedConcurrentHashMap[K, V](len(m.shards))
+	for k, v := range m.All() {
+		if predicate(k, v) {
+			filtered.Put(k, v)
+		}
+	}
+	return filtered
+}
+
+func (m *ConcurrentHashMap[K, V]) Clone() collection.Map[K, V] {
+	return m.Filter(func(K, V) bool { return true })
+}
+
+func (m *ConcurrentHashMap[K, V]) ToSlice() []collection.Entry[K, V] {
+	entries := make([]collection.Entry[K, V], 0)
+	for k, v := range m.All() {
+		entries = append(entries, collection.Entry[K, V]{Key: k, Value: v})
+	}
+	return entries
+}
+
+func (m *ConcurrentHashMap[K, V]) KeySlice() []K {
+	keys := make([]K, 0)
+	for k := range m.All() {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func (m *ConcurrentHashMap[K, V]) ValueSlice() []V {
+	values := make([]V, 0)
+	for _, v := range m.All() {
+		values = append(values, v)
+	}
+	return values
+}
+
+func (m *ConcurrentHashMap[K, V]) Keys() collection.Collection[K] {
+	return collection.Of(m.KeySlice()...)
+}
+
+func (m *ConcurrentHashMap[K, V]) Values() collection.Collection[V] {
+	return collection.Of(m.ValueSlice()...)
+}
+
+func (m *ConcurrentHashMap[K, V]) Elements() map[K]V {
+	elements := make(map[K]V)
+	for k, v := range m.All() {
+		elements[k] = v
+	}
+	return elements
+}
+
+func (m *ConcurrentHashMap[K, V]) Entries() collection.Collection[collection.Entry[K, V]] {
+	return collection.Of(m.ToSlice()...)
+}
+
+// All returns a weakly consistent sequence over the entries. Each shard is
+// copied under its read lock and yielded after the lock is released, so the
+// loop body may freely read and write the map.
+func (m *ConcurrentHashMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, s := range m.shards {
+			s.mu.RLock()
+			snapshot := Clone(s.elements)
+			s.mu.RUnlock()
+
+			for k, v := range snapshot {
+				if !yield(k, v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (m *ConcurrentHashMap[K, V]) Iterator() iterator.Iterator[collection.Entry[K, V]] {
+	return iterator.FromSeq(entries(m.All()))
+}
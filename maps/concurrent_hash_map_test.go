@@ -0,0 +1,149 @@
+package maps_test
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/avila-r/ego/maps"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcurrent_Basics(t *testing.T) {
+	m := maps.NewConcurrentHashMap[string, int]()
+	assert.True(t, m.IsEmpty())
+
+	m.Put("a", 1)
+	m.Put("b", 2)
+	assert.True(t, m.PutIfAbsent("c", 3))
+	assert.False(t, m.PutIfAbsent("c", 30))
+
+	value, ok := m.Get("c")
+	assert.True(t, ok)
+	assert.Equal(t, 3, value)
+	assert.Equal(t, 3, m.Len())
+	assert.True(t, m.ContainsValue(2))
+
+	m.Delete("a")
+	assert.False(t, m.ContainsKey("a"))
+	assert.Equal(t, map[string]int{"b": 2, "c": 3}, m.Elements())
+
+	m.Clear()
+	assert.True(t, m.IsEmpty())
+}
+
+func TestConcurrent_Shards(t *testing.T) {
+	m := maps.NewShardedConcurrentHashMap[int, int](3)
+	for i := range 100 {
+		m.Put(i, i)
+	}
+
+	assert.Equal(t, 100, m.Len())
+	assert.ElementsMatch(t, m.KeySlice(), m.ValueSlice())
+}
+
+func TestConcurrent_CompareAndSwap(t *testing.T) {
+	m := maps.NewConcurrentHashMap[string, []int]()
+	m.Put("k", []int{1})
+
+	assert.False(t, m.CompareAndSwap("k", []int{2}, []int{3}))
+	assert.True(t, m.CompareAndSwap("k", []int{1}, []int{3}))
+	assert.False(t, m.CompareAndSwap("missing", nil, []int{1}))
+
+	value, _ := m.Get("k")
+	assert.Equal(t, []int{3}, value)
+}
+
+func TestConcurrent_Compute(t *testing.T) {
+	m := maps.NewConcurrentHashMap[string, int]()
+	increment := func(_ string, value int, _ bool) (int, bool) { return value + 1, true }
+
+	value, ok := m.Compute("a", increment)
+	assert.True(t, ok)
+	assert.Equal(t, 1, value)
+
+	value, _ = m.Compute("a", increment)
+	assert.Equal(t, 2, value)
+
+	_, ok = m.Compute("a", func(string, int, bool) (int, bool) { return 0, false })
+	assert.False(t, ok)
+	assert.False(t, m.ContainsKey("a"))
+}
+
+func TestConcurrent_ComputeIfAbsentAndPresent(t *testing.T) {
+	m := maps.NewConcurrentHashMap[string, int]()
+
+	assert.Equal(t, 5, m.ComputeIfAbsent("a", func(string) int { return 5 }))
+	assert.Equal(t, 5, m.ComputeIfAbsent("a", func(string) int { return 6 }))
+
+	_, ok := m.ComputeIfPresent("b", func(string, int) (int, bool) { return 1, true })
+	assert.False(t, ok)
+	assert.False(t, m.ContainsKey("b"))
+
+	value, ok := m.ComputeIfPresent("a", func(_ string, v int) (int, bool) { return v * 2, true })
+	assert.True(t, ok)
+	assert.Equal(t, 10, value)
+}
+
+func TestConcurrent_Merge(t *testing.T) {
+	m := maps.NewConcurrentHashMap[string, int]()
+	sum := func(old, value int) (int, bool) { return old + value, true }
+
+	m.Merge("a", 2, sum)
+	value, _ := m.Merge("a", 3, sum)
+	assert.Equal(t, 5, value)
+
+	_, ok := m.Merge("a", 0, func(int, int) (int, bool) { return 0, false })
+	assert.False(t, ok)
+	assert.True(t, m.IsEmpty())
+}
+
+func TestConcurrent_ParallelUpdates(t *testing.T) {
+	m := maps.NewConcurrentHashMap[string, int]()
+	var loads atomic.Int64
+
+	var wg sync.WaitGroup
+	for g := range 16 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 1000 {
+				key := fmt.Sprintf("key-%d", i%10)
+				m.Merge(key, 1, func(old, value int) (int, bool) { return old + value, true })
+				m.ComputeIfAbsent(fmt.Sprintf("lazy-%d", i%5), func(string) int {
+					loads.Add(1)
+					return g
+				})
+			}
+		}()
+	}
+	wg.Wait()
+
+	for i := range 10 {
+		value, _ := m.Get(fmt.Sprintf("key-%d", i))
+		assert.Equal(t, 1600, value)
+	}
+	assert.Equal(t, int64(5), loads.Load())
+}
+
+func TestConcurrent_SnapshotIteration(t *testing.T) {
+	m := maps.NewConcurrentHashMap[int, int]()
+	for i := range 10 {
+		m.Put(i, i)
+	}
+
+	visited := 0
+	for k := range m.All() {
+		m.Delete(k)
+		m.Put(k+100, k)
+		visited++
+		if visited == 10 {
+			break
+		}
+	}
+
+	assert.Equal(t, 10, visited)
+	assert.Equal(t, 10, m.Len())
+	assert.Len(t, m.Iterator().Collect(), 10)
+}
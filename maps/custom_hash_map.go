@@ -0,0 +1,296 @@
+package maps
+
+import (
+	"bytes"
+	"iter"
+	"reflect"
+	"slices"
+
+	"github.com/avila-r/ego/collection"
+	"github.com/avila-r/ego/iterator"
+)
+
+type customEntry[K any, V any] struct {
+	key   K
+	value V
+}
+
+// CustomHashMap is a hash map that hashes and compares keys with the given
+// functions instead of Go's built-in equality, so it can be keyed by
+// slices or by values whose equality differs from ==. Iteration order is
+// unspecified.
+//
+// It implements collection.AnyMap. When K is comparable, AsMap turns it
+// into a full collection.Map.
+type CustomHashMap[K any, V any] struct {
+	buckets map[uint64][]customEntry[K, V]
+	size    int
+	hasher  func(K) uint64
+	equal   func(a, b K) bool
+}
+
+// comparableMap adds the comparable-key parts of collection.Map to a
+// CustomHashMap
+type comparableMap[K comparable, V any] struct {
+	*CustomHashMap[K, V]
+}
+
+var (
+	_ collection.AnyMap[[]byte, int] = (*CustomHashMap[[]byte, int])(nil)
+	_ collection.Map[string, int]    = comparableMap[string, int]{}
+)
+
+// NewCustomHashMap creates an empty map. Keys that are equal must have
+// the same hash.
+func NewCustomHashMap[K any, V any](hasher func(K) uint64, equal func(a, b K) bool) *CustomHashMap[K, V] {
+	return &CustomHashMap[K, V]{
+		buckets: make(map[uint64][]customEntry[K, V]),
+		hasher:  hasher,
+		equal:   equal,
+	}
+}
+
+// NewBytesHashMap creates an empty map keyed by byte slice content
+func NewBytesHashMap[V any]() *CustomHashMap[[]byte, V] {
+	return NewCustomHashMap[[]byte, V](HashBytes, bytes.Equal)
+}
+
+// NewCaseInsensitiveHashMap creates an empty map whose string keys are
+// compared ignoring case. The first spelling put is the one kept.
+func NewCaseInsensitiveHashMap[V any]() *CustomHashMap[string, V] {
+	return NewCustomHashMap[string, V](HashFold, EqualFold)
+}
+
+// find returns key's hash and its index in that bucket, or -1
+func (m *CustomHashMap[K, V]) find(key K) (uint64, int) {
+	hash := m.hasher(key)
+	for i, entry := range m.buckets[hash] {
+		if m.equal(entry.key, key) {
+			return hash, i
+		}
+	}
+	return hash, -1
+}
+
+func (m *CustomHashMap[K, V]) Get(key K) (V, bool) {
+	hash, i := m.find(key)
+	if i < 0 {
+		var zero V
+		return zero, false
+	}
+	return m.buckets[hash][i].value, true
+}
+
+func (m *CustomHashMap[K, V]) Put(key K, value V) {
+	hash, i := m.find(key)
+	if i >= 0 {
+		m.buckets[hash][i].value = value
+		return
+	}
+	m.buckets[hash] = append(m.buckets[hash], customEntry[K, V]{key, value})
+	m.size++
+}
+
+func (m *CustomHashMap[K, V]) PutIfAbsent(key K, value V) bool {
+	if _, i := m.find(key); i >= 0 {
+		return false
+	}
+	m.Put(key, value)
+	return true
+}
+
+func (m *CustomHashMap[K, V]) Delete(key K) {
+	hash, i := m.find(key)
+	if i < 0 {
+		return
+	}
+	if bucket := slices.Delete(m.buckets[hash], i, i+1); len(bucket) > 0 {
+		m.buckets[hash] = bucket
+	} else {
+		delete(m.buckets, hash)
+	}
+	m.size--
+}
+
+func (m *CustomHashMap[K, V]) Clear() {
+	m.buckets = make(map[uint64][]customEntry[K, V])
+	m.size = 0
+}
+
+func (m *CustomHashMap[K, V]) Len() int {
+	return m.size
+}
+
+func (m *CustomHashMap[K, V]) IsEmpty() bool {
+	return m.size == 0
+}
+
+func (m *CustomHashMap[K, V]) ContainsKey(key K) bool {
+	_, i := m.find(key)
+	return i >= 0
+}
+
+func (m *CustomHashMap[K, V]) ContainsValue(value V) bool {
+	for _, v := range m.All() {
+		if reflect.DeepEqual(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *CustomHashMap[K, V]) Filter(predicate func(K, V) bool) collection.AnyMap[K, V] {
+	return m.filter(predicate)
+}
+
+func (m *CustomHashMap[K, V]) filter(predicate func(K, V) bool) *CustomHashMap[K, V] {
+	filtered := NewCustomHashMap[K, V](m.hasher, m.equal)
+	for k, v := range m.All() {
+		if predicate(k, v) {
+			filtered.Put(k, v)
+		}
+	}
+	return filtered
+}
+
+func (m *CustomHashMap[K, V]) Clone() collection.AnyMap[K, V] {
+	return m.filter(func(K, V) bool { return true })
+}
+
+func (m *CustomHashMap[K, V]) ToSlice() []collection.Entry[K, V] {
+	entries := make([]collection.Entry[K, V], 0, m.size)
+	for k, v := range m.All() {
+		entries = append(entries, collection.Entry[K, V]{Key: k, Value: v})
+	}
+	return entries
+}
+
+func (m *CustomHashMap[K, V]) KeySlice() []K {
+	keys := make([]K, 0, m.size)
+	for k := range m.All() {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func (m *CustomHashMap[K, V]) ValueSlice() []V {
+	values := make([]V, 0, m.size)
+	for _, v := range m.All() {
+		values = append(values, v)
+	}
+	return values
+}
+
+func (m *CustomHashMap[K, V]) Keys() collection.Collection[K] {
+	return collection.Of(m.KeySlice()...)
+}
+
+func (m *CustomHashMap[K, V]) Values() collection.Collection[V] {
+	return collection.Of(m.ValueSlice()...)
+}
+
+func (m *CustomHashMap[K, V]) Entries() collection.Collection[collection.Entry[K, V]] {
+	return collection.Of(m.ToSlice()...)
+}
+
+func (m *CustomHashMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, bucket := range m.buckets {
+			for _, entry := range bucket {
+				if !yield(entry.key, entry.value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (m *CustomHashMap[K, V]) Iterator() iterator.Iterator[collection.Entry[K, V]] {
+	return iterator.FromSeq(entries(m.All()))
+}
+
+func (m *CustomHashMap[K, V]) GetOrDefault(key K, fallback V) V {
+	return getOrDefault(m, key, fallback)
+}
+
+func (m *CustomHashMap[K, V]) Compute(key K, remapping func(key K, value V, present bool) (V, bool)) (V, bool) {
+	return compute(m, key, remapping)
+}
+
+func (m *CustomHashMap[K, V]) ComputeIfAbsent(key K, mapping func(key K) V) V {
+	return computeIfAbsent(m, key, mapping)
+}
+
+func (m *CustomHashMap[K, V]) ComputeIfPresent(key K, remapping func(key K, value V) (V, bool)) (V, bool) {
+	return computeIfPresent(m, key, remapping)
+}
+
+func (m *CustomHashMap[K, V]) Merge(key K, value V, remapping func(old, value V) (V, bool)) (V, bool) {
+	return merge(m, key, value, remapping)
+}
+
+func (m *CustomHashMap[K, V]) ReplaceAll(function func(K, V) V) {
+	for _, bucket := range m.buckets {
+		for i := range bucket {
+			bucket[i].value = function(bucket[i].key, bucket[i].value)
+		}
+	}
+}
+
+func (m *CustomHashMap[K, V]) PutAll(other collection.AnyMap[K, V]) {
+	putAll(m, other)
+}
+
+func (m *CustomHashMap[K, V]) RemoveIf(predicate func(K, V) bool) bool {
+	removed := false
+	for hash, bucket := range m.buckets {
+		kept := slices.DeleteFunc(bucket, func(entry customEntry[K, V]) bool {
+			return predicate(entry.key, entry.value)
+		})
+		if len(kept) == len(bucket) {
+			continue
+		}
+		removed = true
+		m.size -= len(bucket) - len(kept)
+		if len(kept) > 0 {
+			m.buckets[hash] = kept
+		} else {
+			delete(m.buckets, hash)
+		}
+	}
+	return removed
+}
+
+func (m *CustomHashMap[K, V]) ForEach(action func(K, V)) {
+	for k, v := range m.All() {
+		action(k, v)
+	}
+}
+
+// AsMap returns m as a collection.Map. The result shares m's entries;
+// Filter and Clone on it return new CustomHashMaps with m's hashing.
+func AsMap[K comparable, V any](m *CustomHashMap[K, V]) collection.Map[K, V] {
+	return comparableMap[K, V]{m}
+}
+
+func (m comparableMap[K, V]) Filter(predicate func(K, V) bool) collection.Map[K, V] {
+	return comparableMap[K, V]{m.filter(predicate)}
+}
+
+func (m comparableMap[K, V]) Clone() collection.Map[K, V] {
+	return m.Filter(func(K, V) bool { return true })
+}
+
+func (m comparableMap[K, V]) PutAll(other collection.Map[K, V]) {
+	putAll(m.CustomHashMap, other)
+}
+
+// Elements returns the entries as a Go map, keyed by the spelling of each
+// key that was stored
+func (m comparableMap[K, V]) Elements() map[K]V {
+	elements := make(map[K]V, m.size)
+	for k, v := range m.All() {
+		elements[k] = v
+	}
+	return elements
+}
@@ -0,0 +1,166 @@
+package maps_test
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/avila-r/ego/maps"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_CustomHashMap_Bytes(t *testing.T) {
+	m := maps.NewBytesHashMap[int]()
+
+	m.Put([]byte("key"), 1)
+	m.Put([]byte("key"), 2)
+	m.Put([]byte("other"), 3)
+
+	value, ok := m.Get([]byte("key"))
+	assert.True(t, ok)
+	assert.Equal(t, 2, value)
+	assert.Equal(t, 2, m.Len())
+
+	m.Delete([]byte("key"))
+	assert.False(t, m.ContainsKey([]byte("key")))
+	assert.Equal(t, 1, m.Len())
+}
+
+func Test_CustomHashMap_CaseInsensitive(t *testing.T) {
+	headers := maps.NewCaseInsensitiveHashMap[string]()
+
+	headers.Put("Content-Type", "text/plain")
+	headers.Put("content-type", "application/json")
+	assert.False(t, headers.PutIfAbsent("CONTENT-TYPE", "text/html"))
+
+	assert.Equal(t, 1, headers.Len())
+	assert.Equal(t, "application/json", headers.GetOrDefault("CoNtEnT-tYpE", ""))
+	assert.Equal(t, []string{"Content-Type"}, headers.KeySlice())
+}
+
+func Test_CustomHashMap_Collisions(t *testing.T) {
+	// Every key lands in the same bucket, so equality alone tells them apart
+	m := maps.NewCustomHashMap[[]int, string](
+		func([]int) uint64 { return 7 },
+		slices.Equal[[]int],
+	)
+
+	for i := range 10 {
+		m.Put([]int{i, i}, strings.Repeat("x", i))
+	}
+	m.Delete([]int{3, 3})
+	m.Delete([]int{9, 9})
+	m.Delete([]int{42})
+
+	assert.Equal(t, 8, m.Len())
+	for i := range 10 {
+		value, ok := m.Get([]int{i, i})
+		assert.Equal(t, i != 3 && i != 9, ok, i)
+		if ok {
+			assert.Equal(t, strings.Repeat("x", i), value)
+		}
+	}
+}
+
+func Test_CustomHashMap_Composite(t *testing.T) {
+	type Key struct {
+		ID     []byte
+		Region string
+	}
+
+	hasher := maps.Composite(
+		func(k Key) uint64 { return maps.HashBytes(k.ID) },
+		func(k Key) uint64 { return maps.HashFold(k.Region) },
+	)
+	equal := func(a, b Key) bool {
+		return bytes.Equal(a.ID, b.ID) && strings.EqualFold(a.Region, b.Region)
+	}
+	m := maps.NewCustomHashMap[Key, int](hasher, equal)
+
+	m.Put(Key{[]byte{1}, "EU"}, 1)
+	m.Put(Key{[]byte{1}, "eu"}, 2)
+	m.Put(Key{[]byte{1}, "US"}, 3)
+	m.Put(Key{[]byte{2}, "EU"}, 4)
+
+	assert.Equal(t, 3, m.Len())
+	assert.Equal(t, 2, m.GetOrDefault(Key{[]byte{1}, "Eu"}, 0))
+	assert.NotEqual(t, hasher(Key{[]byte{1}, "EU"}), hasher(Key{[]byte{1}, "US"}))
+}
+
+func Test_HashFold(t *testing.T) {
+	type Case struct {
+		a, b string
+	}
+
+	// Pairs equal under strings.EqualFold, including runes whose fold orbit
+	// has more than two members
+	cases := []Case{
+		{"hello", "HeLLo"},
+		{"straße", "STRAßE"},
+		{"k", "K"},
+		{"s", "ſ"},
+		{"Σίσυφος", "ΣΊΣΥΦΟΣ"},
+	}
+
+	for _, c := range cases {
+		assert.True(t, maps.EqualFold(c.a, c.b))
+		assert.Equal(t, maps.HashFold(c.a), maps.HashFold(c.b), c.a)
+	}
+	assert.NotEqual(t, maps.HashFold("a"), maps.HashFold("b"))
+}
+
+func Test_CustomHashMap_Operations(t *testing.T) {
+	m := maps.NewBytesHashMap[int]()
+	for i, key := range []string{"a", "b", "c", "d"} {
+		m.Put([]byte(key), i)
+	}
+
+	m.Merge([]byte("a"), 10, func(old, value int) (int, bool) { return old + value, true })
+	assert.Equal(t, 10, m.GetOrDefault([]byte("a"), -1))
+
+	assert.Equal(t, 4, m.ComputeIfAbsent([]byte("e"), func([]byte) int { return 4 }))
+	m.ReplaceAll(func(_ []byte, v int) int { return v * 2 })
+	assert.True(t, m.ContainsValue(8))
+
+	assert.True(t, m.RemoveIf(func(_ []byte, v int) bool { return v < 5 }))
+	assert.False(t, m.RemoveIf(func(_ []byte, v int) bool { return v < 0 }))
+	assert.ElementsMatch(t, []int{20, 6, 8}, m.ValueSlice())
+	assert.Equal(t, 3, m.Len())
+
+	clone := m.Clone()
+	clone.Put([]byte("z"), 0)
+	assert.Equal(t, 3, m.Len())
+
+	filtered := m.Filter(func(_ []byte, v int) bool { return v > 7 })
+	assert.Equal(t, 2, filtered.Len())
+
+	m.PutAll(clone)
+	assert.Equal(t, 4, m.Len())
+	assert.Len(t, m.Iterator().Collect(), 4)
+
+	m.Clear()
+	assert.True(t, m.IsEmpty())
+}
+
+func Test_CustomHashMap_AsMap(t *testing.T) {
+	headers := maps.NewCaseInsensitiveHashMap[int]()
+	headers.Put("Accept", 1)
+
+	m := maps.AsMap(headers)
+	m.Put("ACCEPT", 2)
+	m.Put("Host", 3)
+
+	assert.Equal(t, 2, headers.GetOrDefault("accept", 0))
+	assert.Equal(t, map[string]int{"Accept": 2, "Host": 3}, m.Elements())
+
+	clone := m.Clone()
+	assert.True(t, clone.ContainsKey("host"))
+
+	other := maps.NewHashMap[string, int]()
+	other.Put("HOST", 4)
+	m.PutAll(other)
+	assert.Equal(t, 4, headers.GetOrDefault("host", 0))
+	assert.Equal(t, 3, clone.GetOrDefault("host", 0))
+	assert.Equal(t, 2, m.Filter(func(string, int) bool { return true }).Len())
+}
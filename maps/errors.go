@@ -0,0 +1,13 @@
+package maps
+
+import (
+	"github.com/avila-r/ego"
+)
+
+var errors = ego.ExtendedGoErrorsNamespace.Class("maps")
+
+var (
+	ErrDuplicateValue = errors.New("value is already bound to another key")
+	ErrUnsupportedKey = errors.New("key type can't be used as a JSON object key")
+	ErrNotAnObject    = errors.New("expected a JSON object")
+)
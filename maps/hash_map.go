@@ -1,6 +1,8 @@
 package maps
 
 import (
+	"iter"
+	std "maps"
 	"reflect"
 
 	"github.com/avila-r/ego/collection"
@@ -128,6 +130,52 @@ func (m *HashMap[K, V]) Entries() collection.Collection[collection.Entry[K, V]]
 	return collection.Of(m.ToSlice()...)
 }
 
+func (m *HashMap[K, V]) All() iter.Seq2[K, V] {
+	return std.All(m.elements)
+}
+
 func (m *HashMap[K, V]) Iterator() iterator.Iterator[collection.Entry[K, V]] {
-	return iterator.Of(m.ToSlice()...)
+	return iterator.FromSeq(entries(m.All()))
+}
+
+func (m *HashMap[K, V]) GetOrDefault(key K, fallback V) V {
+	return getOrDefault(m, key, fallback)
+}
+
+func (m *HashMap[K, V]) Compute(key K, remapping func(key K, value V, present bool) (V, bool)) (V, bool) {
+	return compute(m, key, remapping)
+}
+
+func (m *HashMap[K, V]) ComputeIfAbsent(key K, mapping func(key K) V) V {
+	return computeIfAbsent(m, key, mapping)
+}
+
+func (m *HashMap[K, V]) ComputeIfPresent(key K, remapping func(key K, value V) (V, bool)) (V, bool) {
+	return computeIfPresent(m, key, remapping)
+}
+
+func (m *HashMap[K, V]) Merge(key K, value V, remapping func(old, value V) (V, bool)) (V, bool) {
+	return merge(m, key, value, remapping)
+}
+
+func (m *HashMap[K, V]) ReplaceAll(function func(K, V) V) {
+	for k, v := range m.elements {
+		m.elements[k] = function(k, v)
+	}
+}
+
+func (m *HashMap[K, V]) PutAll(other collection.Map[K, V]) {
+	putAll(m, other)
+}
+
+func (m *HashMap[K, V]) RemoveIf(predicate func(K, V) bool) bool {
+	size := len(m.elements)
+	std.DeleteFunc(m.elements, predicate)
+	return len(m.elements) != size
+}
+
+func (m *HashMap[K, V]) ForEach(action func(K, V)) {
+	for k, v := range m.elements {
+		action(k, v)
+	}
 }
@@ -1,7 +1,9 @@
 package maps_test
 
 import (
+	"runtime"
 	"testing"
+	"time"
 
 	"github.com/avila-r/ego/collection"
 	"github.com/avila-r/ego/maps"
@@ -344,3 +346,40 @@ func Test_Iterator(t *testing.T) {
 	assert.Equal(t, 2, keys["b"])
 	assert.Equal(t, 3, keys["c"])
 }
+
+func Test_Iterator_Abandoned(t *testing.T) {
+	m := maps.EmptyHashMap[string, int]()
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	before := runtime.NumGoroutine()
+	for range 10 {
+		it := m.Iterator()
+		assert.True(t, it.HasNext())
+	}
+
+	// Each iterator pulls on a goroutine that is released once the
+	// iterator is garbage collected
+	for range 100 {
+		runtime.GC()
+		if runtime.NumGoroutine() <= before {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Errorf("%d pull goroutines still running", runtime.NumGoroutine()-before)
+}
+
+func Test_All(t *testing.T) {
+	m := maps.EmptyHashMap[string, int]()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+
+	result := make(map[string]int)
+	for k, v := range m.All() {
+		result[k] = v
+	}
+
+	assert.Equal(t, map[string]int{"a": 1, "b": 2, "c": 3}, result)
+}
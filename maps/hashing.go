@@ -0,0 +1,76 @@
+package maps
+
+import (
+	"encoding/binary"
+	"hash/maphash"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// seed is shared by the built-in hashers so that equal keys hash equally
+// across maps within a process
+var seed = maphash.MakeSeed()
+
+// HashBytes hashes a byte slice by content; pair it with bytes.Equal
+func HashBytes(key []byte) uint64 {
+	return maphash.Bytes(seed, key)
+}
+
+// HashString hashes a string by content
+func HashString(key string) uint64 {
+	return maphash.String(seed, key)
+}
+
+// HashComparable hashes any comparable value the way a Go map would
+func HashComparable[T comparable](key T) uint64 {
+	return maphash.Comparable(seed, key)
+}
+
+// HashFold hashes a string ignoring case, so that strings equal under
+// strings.EqualFold hash equally; pair it with EqualFold
+func HashFold(key string) uint64 {
+	var h maphash.Hash
+	h.SetSeed(seed)
+	var buffer [utf8.UTFMax]byte
+	for _, r := range key {
+		n := utf8.EncodeRune(buffer[:], fold(r))
+		h.Write(buffer[:n])
+	}
+	return h.Sum64()
+}
+
+// EqualFold reports whether two strings are equal ignoring case
+func EqualFold(a, b string) bool {
+	return strings.EqualFold(a, b)
+}
+
+// fold maps r to the smallest rune of its case folding orbit, the same
+// equivalence strings.EqualFold uses
+func fold(r rune) rune {
+	smallest := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		smallest = min(smallest, f)
+	}
+	return smallest
+}
+
+// Composite builds a hasher for a key made of several parts from one
+// hasher per part, for example
+//
+//	maps.Composite(
+//		func(k Key) uint64 { return maps.HashBytes(k.ID) },
+//		func(k Key) uint64 { return maps.HashFold(k.Region) },
+//	)
+func Composite[K any](parts ...func(K) uint64) func(K) uint64 {
+	return func(key K) uint64 {
+		var h maphash.Hash
+		h.SetSeed(seed)
+		var buffer [8]byte
+		for _, part := range parts {
+			binary.LittleEndian.PutUint64(buffer[:], part(key))
+			h.Write(buffer[:])
+		}
+		return h.Sum64()
+	}
+}
@@ -1,6 +1,7 @@
 package maps
 
 import (
+	"iter"
 	"reflect"
 
 	"github.com/avila-r/ego/collection"
@@ -58,17 +59,7 @@ func (m *LinkedHashMap[K, V]) Put(key K, value V) {
 	}
 
 	m.elements[key] = newNode
-
-	// Add to linked list
-	if m.tail == nil {
-		m.head = newNode
-		m.tail = newNode
-	} else {
-		m.tail.next = newNode
-		newNode.prev = m.tail
-		m.tail = newNode
-	}
-
+	m.link(newNode)
 	m.size++
 }
 
@@ -86,7 +77,24 @@ func (m *LinkedHashMap[K, V]) Delete(key K) {
 		return
 	}
 
-	// Remove from linked list
+	m.unlink(node)
+	delete(m.elements, key)
+	m.size--
+}
+
+// link appends node to the end of the list
+func (m *LinkedHashMap[K, V]) link(node *linkedNode[K, V]) {
+	node.next = nil
+	node.prev = m.tail
+	if m.tail == nil {
+		m.head = node
+	} else {
+		m.tail.next = node
+	}
+	m.tail = node
+}
+
+func (m *LinkedHashMap[K, V]) unlink(node *linkedNode[K, V]) {
 	if node.prev != nil {
 		node.prev.next = node.next
 	} else {
@@ -98,9 +106,46 @@ func (m *LinkedHashMap[K, V]) Delete(key K) {
 	} else {
 		m.tail = node.prev
 	}
+}
 
-	delete(m.elements, key)
-	m.size--
+// MoveToBack moves key to the end of the iteration order, as if it had just
+// been inserted. Calling it on every read turns the map into an access-ordered
+// (LRU) map.
+func (m *LinkedHashMap[K, V]) MoveToBack(key K) bool {
+	node, exists := m.elements[key]
+	if !exists {
+		return false
+	}
+	if node != m.tail {
+		m.unlink(node)
+		m.link(node)
+	}
+	return true
+}
+
+// First returns the oldest entry in iteration order
+func (m *LinkedHashMap[K, V]) First() (collection.Entry[K, V], bool) {
+	if m.head == nil {
+		return collection.Entry[K, V]{}, false
+	}
+	return collection.Entry[K, V]{Key: m.head.key, Value: m.head.value}, true
+}
+
+// Last returns the newest entry in iteration order
+func (m *LinkedHashMap[K, V]) Last() (collection.Entry[K, V], bool) {
+	if m.tail == nil {
+		return collection.Entry[K, V]{}, false
+	}
+	return collection.Entry[K, V]{Key: m.tail.key, Value: m.tail.value}, true
+}
+
+// PollFirst removes and returns the oldest entry
+func (m *LinkedHashMap[K, V]) PollFirst() (collection.Entry[K, V], bool) {
+	first, ok := m.First()
+	if ok {
+		m.Delete(first.Key)
+	}
+	return first, ok
 }
 
 func (m *LinkedHashMap[K, V]) Clear() {
@@ -211,6 +256,75 @@ func (m *LinkedHashMap[K, V]) Entries() collection.Collection[collection.Entry[K
 	return collection.Of(m.ToSlice()...)
 }
 
+func (m *LinkedHashMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for current := m.head; current != nil; current = current.next {
+			if !yield(current.key, current.value) {
+				return
+			}
+		}
+	}
+}
+
 func (m *LinkedHashMap[K, V]) Iterator() iterator.Iterator[collection.Entry[K, V]] {
-	return iterator.From(m.Entries())
+	current := m.head
+	next := func() (collection.Entry[K, V], bool) {
+		if current == nil {
+			return collection.Entry[K, V]{}, false
+		}
+		e := collection.Entry[K, V]{Key: current.key, Value: current.value}
+		current = current.next
+		return e, true
+	}
+	reset := func() {
+		current = m.head
+	}
+	return iterator.FromFunc(next, reset)
+}
+
+func (m *LinkedHashMap[K, V]) GetOrDefault(key K, fallback V) V {
+	return getOrDefault(m, key, fallback)
+}
+
+func (m *LinkedHashMap[K, V]) Compute(key K, remapping func(key K, value V, present bool) (V, bool)) (V, bool) {
+	return compute(m, key, remapping)
+}
+
+func (m *LinkedHashMap[K, V]) ComputeIfAbsent(key K, mapping func(key K) V) V {
+	return computeIfAbsent(m, key, mapping)
+}
+
+func (m *LinkedHashMap[K, V]) ComputeIfPresent(key K, remapping func(key K, value V) (V, bool)) (V, bool) {
+	return computeIfPresent(m, key, remapping)
+}
+
+func (m *LinkedHashMap[K, V]) Merge(key K, value V, remapping func(old, value V) (V, bool)) (V, bool) {
+	return merge(m, key, value, remapping)
+}
+
+func (m *LinkedHashMap[K, V]) ReplaceAll(function func(K, V) V) {
+	for current := m.head; current != nil; current = current.next {
+		current.value = function(current.key, current.value)
+	}
+}
+
+func (m *LinkedHashMap[K, V]) PutAll(other collection.Map[K, V]) {
+	putAll(m, other)
+}
+
+func (m *LinkedHashMap[K, V]) RemoveIf(predicate func(K, V) bool) bool {
+	removed := false
+	for current := m.head; current != nil; current = current.next {
+		if predicate(current.key, current.value) {
+			m.Delete(current.key)
+			removed = true
+		}
+	}
+	return removed
+}
+
+func (m *LinkedHashMap[K, V]) ForEach(action func(K, V)) {
+	for current := m.head; current != nil; current = current.next {
+		action(current.key, current.value)
+	}
 }
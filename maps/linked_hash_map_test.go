@@ -443,3 +443,88 @@ func TestLinked_Iterator(t *testing.T) {
 
 	assert.Equal(t, expected, entries)
 }
+
+func TestLinked_Iterator_Reset(t *testing.T) {
+	m := maps.EmptyLinkedHashMap[string, int]()
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	iter := m.Iterator()
+	assert.Equal(t, "a", iter.Next().Key)
+	assert.Equal(t, 1, iter.Remaining())
+
+	iter.Reset()
+	assert.Equal(t, []string{"a", "b"}, []string{iter.Next().Key, iter.Next().Key})
+	assert.False(t, iter.HasNext())
+}
+
+func TestLinked_All(t *testing.T) {
+	m := maps.EmptyLinkedHashMap[string, int]()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	var keys []string
+	var values []int
+	for k, v := range m.All() {
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+
+	assert.Equal(t, []string{"c", "a", "b"}, keys)
+	assert.Equal(t, []int{3, 1, 2}, values)
+}
+
+func TestLinked_MoveToBack(t *testing.T) {
+	type Case struct {
+		name     string
+		move     []string
+		expected []string
+	}
+
+	cases := []Case{
+		{"head", []string{"a"}, []string{"b", "c", "a"}},
+		{"middle", []string{"b"}, []string{"a", "c", "b"}},
+		{"tail is a no-op", []string{"c"}, []string{"a", "b", "c"}},
+		{"missing key", []string{"z"}, []string{"a", "b", "c"}},
+		{"access sequence", []string{"a", "b", "a"}, []string{"c", "b", "a"}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			m := maps.EmptyLinkedHashMap[string, int]()
+			m.Put("a", 1)
+			m.Put("b", 2)
+			m.Put("c", 3)
+
+			for _, key := range c.move {
+				assert.Equal(t, key != "z", m.MoveToBack(key))
+			}
+			assert.Equal(t, c.expected, m.KeySlice())
+
+			last, _ := m.Last()
+			assert.Equal(t, c.expected[2], last.Key)
+		})
+	}
+}
+
+func TestLinked_PollFirst(t *testing.T) {
+	m := maps.EmptyLinkedHashMap[string, int]()
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	first, ok := m.PollFirst()
+	assert.True(t, ok)
+	assert.Equal(t, collection.Entry[string, int]{Key: "a", Value: 1}, first)
+
+	first, _ = m.First()
+	assert.Equal(t, "b", first.Key)
+
+	m.PollFirst()
+	_, ok = m.PollFirst()
+	assert.False(t, ok)
+	assert.True(t, m.IsEmpty())
+
+	m.Put("c", 3)
+	assert.Equal(t, []string{"c"}, m.KeySlice())
+}
@@ -1,6 +1,7 @@
 package maps
 
 import (
+	"iter"
 	std "maps"
 
 	"github.com/avila-r/ego/collection"
@@ -65,7 +66,18 @@ func Values[M ~map[K]V, K comparable, V any](m M) collection.Collection[V] {
 
 // Iter returns an Iterator over the map's key/value pairs as Entry objects.
 func Iter[M ~map[K]V, K comparable, V any](m M) iterator.Iterator[collection.Entry[K, V]] {
-	return Entries(m).Iterator()
+	return iterator.FromSeq(entries(std.All(m)))
+}
+
+// entries adapts a key/value sequence into a sequence of Entry objects.
+func entries[K any, V any](seq iter.Seq2[K, V]) iter.Seq[collection.Entry[K, V]] {
+	return func(yield func(collection.Entry[K, V]) bool) {
+		for k, v := range seq {
+			if !yield(collection.Entry[K, V]{Key: k, Value: v}) {
+				return
+			}
+		}
+	}
 }
 
 func From[K comparable, V any](m Map[K, V]) collection.Map[K, V] {
@@ -0,0 +1,232 @@
+package maps
+
+import (
+	"iter"
+	"slices"
+
+	"github.com/avila-r/ego/collection"
+	"github.com/avila-r/ego/iterator"
+)
+
+// bucket holds the values bound to one key of a Multimap
+type bucket[V comparable] interface {
+	add(value V) bool
+	remove(value V) bool
+	contains(value V) bool
+	size() int
+	all() iter.Seq[V]
+}
+
+// listBucket keeps every value, duplicates included, in insertion order
+type listBucket[V comparable] struct {
+	values []V
+}
+
+func (b *listBucket[V]) add(value V) bool {
+	b.values = append(b.values, value)
+	return true
+}
+
+// remove drops the first occurrence of value
+func (b *listBucket[V]) remove(value V) bool {
+	i := slices.Index(b.values, value)
+	if i < 0 {
+		return false
+	}
+	b.values = slices.Delete(b.values, i, i+1)
+	return true
+}
+
+func (b *listBucket[V]) contains(value V) bool {
+	return slices.Contains(b.values, value)
+}
+
+func (b *listBucket[V]) size() int {
+	return len(b.values)
+}
+
+func (b *listBucket[V]) all() iter.Seq[V] {
+	return slices.Values(b.values)
+}
+
+// setBucket keeps distinct values in insertion order
+type setBucket[V comparable] struct {
+	values *LinkedHashMap[V, struct{}]
+}
+
+func (b *setBucket[V]) add(value V) bool {
+	return b.values.PutIfAbsent(value, struct{}{})
+}
+
+func (b *setBucket[V]) remove(value V) bool {
+	if !b.values.ContainsKey(value) {
+		return false
+	}
+	b.values.Delete(value)
+	return true
+}
+
+func (b *setBucket[V]) contains(value V) bool {
+	return b.values.ContainsKey(value)
+}
+
+func (b *setBucket[V]) size() int {
+	return b.values.Len()
+}
+
+func (b *setBucket[V]) all() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for value := range b.values.All() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Multimap maps each key to a group of values. Keys and values are kept
+// in insertion order. A key is present as long as it has at least one
+// value.
+type Multimap[K comparable, V comparable] struct {
+	buckets   *LinkedHashMap[K, bucket[V]]
+	newBucket func() bucket[V]
+	size      int
+}
+
+// NewListMultimap creates a multimap that keeps every value put under a
+// key, duplicates included
+func NewListMultimap[K comparable, V comparable]() *Multimap[K, V] {
+	return &Multimap[K, V]{
+		buckets: NewLinkedHashMap[K, bucket[V]](),
+		newBucket: func() bucket[V] {
+			return &listBucket[V]{}
+		},
+	}
+}
+
+// NewSetMultimap creates a multimap that keeps distinct values per key
+func NewSetMultimap[K comparable, V comparable]() *Multimap[K, V] {
+	return &Multimap[K, V]{
+		buckets: NewLinkedHashMap[K, bucket[V]](),
+		newBucket: func() bucket[V] {
+			return &setBucket[V]{values: NewLinkedHashMap[V, struct{}]()}
+		},
+	}
+}
+
+// Put binds value to key. It returns false if a set multimap already had
+// that pair.
+func (m *Multimap[K, V]) Put(key K, value V) bool {
+	b, ok := m.buckets.Get(key)
+	if !ok {
+		b = m.newBucket()
+		m.buckets.Put(key, b)
+	}
+	if !b.add(value) {
+		return false
+	}
+	m.size++
+	return true
+}
+
+// PutAll binds every value to key and reports whether the multimap changed
+func (m *Multimap[K, V]) PutAll(key K, values ...V) bool {
+	changed := false
+	for _, value := range values {
+		if m.Put(key, value) {
+			changed = true
+		}
+	}
+	return changed
+}
+
+// Get returns a copy of the values bound to key, empty if there are none
+func (m *Multimap[K, V]) Get(key K) collection.Collection[V] {
+	b, ok := m.buckets.Get(key)
+	if !ok {
+		return collection.Empty[V]()
+	}
+	return collection.Of(slices.Collect(b.all())...)
+}
+
+// Remove unbinds one occurrence of value from key
+func (m *Multimap[K, V]) Remove(key K, value V) bool {
+	b, ok := m.buckets.Get(key)
+	if !ok || !b.remove(value) {
+		return false
+	}
+	m.size--
+	if b.size() == 0 {
+		m.buckets.Delete(key)
+	}
+	return true
+}
+
+// RemoveAll drops key and returns the values that were bound to it
+func (m *Multimap[K, V]) RemoveAll(key K) collection.Collection[V] {
+	removed := m.Get(key)
+	m.buckets.Delete(key)
+	m.size -= removed.Size()
+	return removed
+}
+
+func (m *Multimap[K, V]) ContainsKey(key K) bool {
+	return m.buckets.ContainsKey(key)
+}
+
+// ContainsEntry reports whether value is bound to key
+func (m *Multimap[K, V]) ContainsEntry(key K, value V) bool {
+	b, ok := m.buckets.Get(key)
+	return ok && b.contains(value)
+}
+
+// Len returns the number of key/value pairs
+func (m *Multimap[K, V]) Len() int {
+	return m.size
+}
+
+func (m *Multimap[K, V]) IsEmpty() bool {
+	return m.size == 0
+}
+
+func (m *Multimap[K, V]) Clear() {
+	m.buckets.Clear()
+	m.size = 0
+}
+
+// KeySet returns the distinct keys, in insertion order
+func (m *Multimap[K, V]) KeySet() collection.Collection[K] {
+	return collection.Of(m.buckets.KeySlice()...)
+}
+
+// Entries returns one entry per key/value pair
+func (m *Multimap[K, V]) Entries() collection.Collection[collection.Entry[K, V]] {
+	c := collection.Sized[collection.Entry[K, V]](m.size)
+	for k, v := range m.All() {
+		c.Add(collection.Entry[K, V]{Key: k, Value: v})
+	}
+	return c
+}
+
+// All returns a sequence over every key/value pair, grouped by key
+func (m *Multimap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for key, b := range m.buckets.All() {
+			for value := range b.all() {
+				if !yield(key, value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (m *Multimap[K, V]) ForEach(action func(K, V)) {
+	for k, v := range m.All() {
+		action(k, v)
+	}
+}
+
+func (m *Multimap[K, V]) Iterator() iterator.Iterator[collection.Entry[K, V]] {
+	return iterator.FromSeq(entries(m.All()))
+}
@@ -0,0 +1,105 @@
+package maps_test
+
+import (
+	"testing"
+
+	"github.com/avila-r/ego/collection"
+	"github.com/avila-r/ego/maps"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Multimap_Put(t *testing.T) {
+	type Case struct {
+		name     string
+		multimap func() *maps.Multimap[string, int]
+		expected map[string][]int
+		size     int
+	}
+
+	cases := []Case{
+		{"list keeps duplicates", maps.NewListMultimap[string, int], map[string][]int{"a": {1, 2, 1}, "b": {3}}, 4},
+		{"set drops duplicates", maps.NewSetMultimap[string, int], map[string][]int{"a": {1, 2}, "b": {3}}, 3},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			m := c.multimap()
+			m.Put("a", 1)
+			m.Put("a", 2)
+			m.Put("b", 3)
+			m.Put("a", 1)
+
+			assert.Equal(t, c.size, m.Len())
+			for key, values := range c.expected {
+				assert.Equal(t, values, m.Get(key).Elements())
+			}
+			assert.True(t, m.Get("missing").IsEmpty())
+			assert.Equal(t, []string{"a", "b"}, m.KeySet().Elements())
+		})
+	}
+}
+
+func Test_Multimap_SetPutReportsChange(t *testing.T) {
+	m := maps.NewSetMultimap[string, string]()
+
+	assert.True(t, m.Put("go", "lang"))
+	assert.False(t, m.Put("go", "lang"))
+	assert.False(t, m.PutAll("go", "lang"))
+	assert.True(t, m.PutAll("go", "lang", "verb"))
+	assert.Equal(t, 2, m.Len())
+}
+
+func Test_Multimap_Remove(t *testing.T) {
+	m := maps.NewListMultimap[string, int]()
+	m.PutAll("a", 1, 2, 1)
+	m.Put("b", 3)
+
+	assert.True(t, m.Remove("a", 1))
+	assert.Equal(t, []int{2, 1}, m.Get("a").Elements())
+	assert.False(t, m.Remove("a", 5))
+	assert.False(t, m.Remove("z", 1))
+
+	assert.True(t, m.Remove("b", 3))
+	assert.False(t, m.ContainsKey("b"))
+	assert.Equal(t, 2, m.Len())
+}
+
+func Test_Multimap_RemoveAll(t *testing.T) {
+	m := maps.NewSetMultimap[string, int]()
+	m.PutAll("a", 1, 2)
+	m.Put("b", 3)
+
+	assert.Equal(t, []int{1, 2}, m.RemoveAll("a").Elements())
+	assert.False(t, m.ContainsKey("a"))
+	assert.Equal(t, 1, m.Len())
+	assert.True(t, m.RemoveAll("a").IsEmpty())
+}
+
+func Test_Multimap_Get_IsCopy(t *testing.T) {
+	m := maps.NewListMultimap[string, int]()
+	m.Put("a", 1)
+
+	m.Get("a").Add(2)
+
+	assert.Equal(t, []int{1}, m.Get("a").Elements())
+}
+
+func Test_Multimap_Entries(t *testing.T) {
+	m := maps.NewListMultimap[string, string]()
+	m.PutAll("go", "gopher", "gofmt")
+	m.Put("rust", "crab")
+
+	expected := []collection.Entry[string, string]{
+		{Key: "go", Value: "gopher"},
+		{Key: "go", Value: "gofmt"},
+		{Key: "rust", Value: "crab"},
+	}
+	assert.Equal(t, expected, m.Entries().Elements())
+	assert.Equal(t, expected, m.Iterator().Collect())
+	assert.True(t, m.ContainsEntry("go", "gofmt"))
+	assert.False(t, m.ContainsEntry("rust", "gofmt"))
+
+	m.Clear()
+	assert.True(t, m.IsEmpty())
+	assert.False(t, m.Iterator().HasNext())
+}
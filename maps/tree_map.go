@@ -0,0 +1,543 @@
+package maps
+
+import (
+	"cmp"
+	"iter"
+	"reflect"
+
+	"github.com/avila-r/ego/collection"
+	"github.com/avila-r/ego/constraint"
+	"github.com/avila-r/ego/function"
+	"github.com/avila-r/ego/iterator"
+)
+
+type treeNode[K comparable, V any] struct {
+	key   K
+	value V
+	left  *treeNode[K, V]
+	right *treeNode[K, V]
+	red   bool
+}
+
+// TreeMap is a map sorted by key, backed by a left-leaning red-black tree.
+// Lookups, insertions and deletions are O(log n) and iteration follows key
+// order.
+type TreeMap[K comparable, V any] struct {
+	root       *treeNode[K, V]
+	size       int
+	comparator function.Comparator[K]
+}
+
+var _ collection.Map[string, int] = (*TreeMap[string, int])(nil)
+
+// NewTreeMap creates an empty map ordered by comparator
+func NewTreeMap[K comparable, V any](comparator function.Comparator[K]) *TreeMap[K, V] {
+	return &TreeMap[K, V]{
+		comparator: comparator,
+	}
+}
+
+// NewOrderedTreeMap creates an empty map ordered by the natural order of K
+func NewOrderedTreeMap[K constraint.Ordered, V any]() *TreeMap[K, V] {
+	return NewTreeMap[K, V](function.NewComparator(cmp.Compare[K]))
+}
+
+func (m *TreeMap[K, V]) compare(a, b K) int {
+	return m.comparator.Compare(a, b)
+}
+
+func (m *TreeMap[K, V]) node(key K) *treeNode[K, V] {
+	for current := m.root; current != nil; {
+		switch c := m.compare(key, current.key); {
+		case c < 0:
+			current = current.left
+		case c > 0:
+			current = current.right
+		default:
+			return current
+		}
+	}
+	return nil
+}
+
+func (m *TreeMap[K, V]) Get(key K) (V, bool) {
+	if node := m.node(key); node != nil {
+		return node.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+func (m *TreeMap[K, V]) Put(key K, value V) {
+	m.root = m.put(m.root, key, value)
+	m.root.red = false
+}
+
+func (m *TreeMap[K, V]) put(h *treeNode[K, V], key K, value V) *treeNode[K, V] {
+	if h == nil {
+		m.size++
+		return &treeNode[K, V]{key: key, value: value, red: true}
+	}
+
+	switch c := m.compare(key, h.key); {
+	case c < 0:
+		h.left = m.put(h.left, key, value)
+	case c > 0:
+		h.right = m.put(h.right, key, value)
+	default:
+		h.value = value
+	}
+
+	return balance(h)
+}
+
+func (m *TreeMap[K, V]) PutIfAbsent(key K, value V) bool {
+	if m.node(key) != nil {
+		return false
+	}
+	m.Put(key, value)
+	return true
+}
+
+func (m *TreeMap[K, V]) Delete(key K) {
+	if m.node(key) == nil {
+		return
+	}
+
+	if !isRed(m.root.left) && !isRed(m.root.right) {
+		m.root.red = true
+	}
+	m.root = m.delete(m.root, key)
+	if m.root != nil {
+		m.root.red = false
+	}
+	m.size--
+}
+
+// delete removes key, which must be present, from the subtree rooted at h
+func (m *TreeMap[K, V]) delete(h *treeNode[K, V], key K) *treeNode[K, V] {
+	if m.compare(key, h.key) < 0 {
+		if !isRed(h.left) && !isRed(h.left.left) {
+			h = moveRedLeft(h)
+		}
+		h.left = m.delete(h.left, key)
+		return balance(h)
+	}
+
+	if isRed(h.left) {
+		h = rotateRight(h)
+	}
+	if m.compare(key, h.key) == 0 && h.right == nil {
+		return nil
+	}
+	if !isRed(h.right) && !isRed(h.right.left) {
+		h = moveRedRight(h)
+	}
+	if m.compare(key, h.key) == 0 {
+		successor := h.right
+		for successor.left != nil {
+			successor = successor.left
+		}
+		h.key, h.value = successor.key, successor.value
+		h.right = deleteMin(h.right)
+	} else {
+		h.right = m.delete(h.right, key)
+	}
+	return balance(h)
+}
+
+func (m *TreeMap[K, V]) Clear() {
+	m.root, m.size = nil, 0
+}
+
+func (m *TreeMap[K, V]) Len() int {
+	return m.size
+}
+
+func (m *TreeMap[K, V]) IsEmpty() bool {
+	return m.size == 0
+}
+
+func (m *TreeMap[K, V]) ContainsKey(key K) bool {
+	return m.node(key) != nil
+}
+
+func (m *TreeMap[K, V]) ContainsValue(value V) bool {
+	for _, v := range m.All() {
+		if reflect.DeepEqual(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *TreeMap[K, V]) Filter(predicate func(K, V) bool) collection.Map[K, V] {
+	filtered := NewTreeMap[K, V](m.comparator)
+	for k, v := range m.All() {
+		if predicate(k, v) {
+			filtered.Put(k, v)
+		}
+	}
+	return filtered
+}
+
+func (m *TreeMap[K, V]) Clone() collection.Map[K, V] {
+	return m.collect(m.All())
+}
+
+func (m *TreeMap[K, V]) ToSlice() []collection.Entry[K, V] {
+	entries := make([]collection.Entry[K, V], 0, m.size)
+	for k, v := range m.All() {
+		entries = append(entries, collection.Entry[K, V]{Key: k, Value: v})
+	}
+	return entries
+}
+
+func (m *TreeMap[K, V]) KeySlice() []K {
+	keys := make([]K, 0, m.size)
+	for k := range m.All() {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func (m *TreeMap[K, V]) ValueSlice() []V {
+	values := make([]V, 0, m.size)
+	for _, v := range m.All() {
+		values = append(values, v)
+	}
+	return values
+}
+
+func (m *TreeMap[K, V]) Keys() collection.Collection[K] {
+	return collection.Of(m.KeySlice()...)
+}
+
+func (m *TreeMap[K, V]) Values() collection.Collection[V] {
+	return collection.Of(m.ValueSlice()...)
+}
+
+func (m *TreeMap[K, V]) Elements() map[K]V {
+	elements := make(map[K]V, m.size)
+	for k, v := range m.All() {
+		elements[k] = v
+	}
+	return elements
+}
+
+func (m *TreeMap[K, V]) Entries() collection.Collection[collection.Entry[K, V]] {
+	return collection.Of(m.ToSlice()...)
+}
+
+// All returns a sequence over the entries in ascending key order
+func (m *TreeMap[K, V]) All() iter.Seq2[K, V] {
+	return m.ascend(bound[K]{}, bound[K]{})
+}
+
+// Backward returns a sequence over the entries in descending key order
+func (m *TreeMap[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.descend(m.root, yield)
+	}
+}
+
+func (m *TreeMap[K, V]) Iterator() iterator.Iterator[collection.Entry[K, V]] {
+	return iterator.Iterate(m.first, func(e collection.Entry[K, V]) (collection.Entry[K, V], bool) {
+		return m.Higher(e.Key)
+	})
+}
+
+// DescendingIterator returns an iterator over the entries in descending key
+// order
+func (m *TreeMap[K, V]) DescendingIterator() iterator.Iterator[collection.Entry[K, V]] {
+	return iterator.Iterate(m.last, func(e collection.Entry[K, V]) (collection.Entry[K, V], bool) {
+		return m.Lower(e.Key)
+	})
+}
+
+// FirstKey returns the smallest key, if the map isn't empty
+func (m *TreeMap[K, V]) FirstKey() (K, bool) {
+	first, ok := m.first()
+	return first.Key, ok
+}
+
+// LastKey returns the largest key, if the map isn't empty
+func (m *TreeMap[K, V]) LastKey() (K, bool) {
+	last, ok := m.last()
+	return last.Key, ok
+}
+
+func (m *TreeMap[K, V]) first() (collection.Entry[K, V], bool) {
+	if m.root == nil {
+		return collection.Entry[K, V]{}, false
+	}
+	current := m.root
+	for current.left != nil {
+		current = current.left
+	}
+	return collection.Entry[K, V]{Key: current.key, Value: current.value}, true
+}
+
+func (m *TreeMap[K, V]) last() (collection.Entry[K, V], bool) {
+	if m.root == nil {
+		return collection.Entry[K, V]{}, false
+	}
+	current := m.root
+	for current.right != nil {
+		current = current.right
+	}
+	return collection.Entry[K, V]{Key: current.key, Value: current.value}, true
+}
+
+// Floor returns the entry with the largest key less than or equal to key
+func (m *TreeMap[K, V]) Floor(key K) (collection.Entry[K, V], bool) {
+	return m.closest(key, true, true)
+}
+
+// Ceiling returns the entry with the smallest key greater than or equal to
+// key
+func (m *TreeMap[K, V]) Ceiling(key K) (collection.Entry[K, V], bool) {
+	return m.closest(key, false, true)
+}
+
+// Lower returns the entry with the largest key strictly less than key
+func (m *TreeMap[K, V]) Lower(key K) (collection.Entry[K, V], bool) {
+	return m.closest(key, true, false)
+}
+
+// Higher returns the entry with the smallest key strictly greater than key
+func (m *TreeMap[K, V]) Higher(key K) (collection.Entry[K, V], bool) {
+	return m.closest(key, false, false)
+}
+
+// closest finds the nearest entry below (or above) key, accepting key itself
+// when inclusive
+func (m *TreeMap[K, V]) closest(key K, below, inclusive bool) (collection.Entry[K, V], bool) {
+	var best *treeNode[K, V]
+	for current := m.root; current != nil; {
+		c := m.compare(key, current.key)
+		if c == 0 && inclusive {
+			best = current
+			break
+		}
+		if below {
+			if c > 0 {
+				best, current = current, current.right
+			} else {
+				current = current.left
+			}
+		} else {
+			if c < 0 {
+				best, current = current, current.left
+			} else {
+				current = current.right
+			}
+		}
+	}
+
+	if best == nil {
+		return collection.Entry[K, V]{}, false
+	}
+	return collection.Entry[K, V]{Key: best.key, Value: best.value}, true
+}
+
+// HeadMap returns a new map with the entries whose keys are strictly less
+// than to
+func (m *TreeMap[K, V]) HeadMap(to K) *TreeMap[K, V] {
+	return m.collect(m.ascend(bound[K]{}, bound[K]{key: to, set: true}))
+}
+
+// TailMap returns a new map with the entries whose keys are greater than or
+// equal to from
+func (m *TreeMap[K, V]) TailMap(from K) *TreeMap[K, V] {
+	return m.collect(m.ascend(bound[K]{key: from, set: true, inclusive: true}, bound[K]{}))
+}
+
+// SubMap returns a new map with the entries whose keys fall in [from, to)
+func (m *TreeMap[K, V]) SubMap(from, to K) *TreeMap[K, V] {
+	return m.collect(m.Range(from, to))
+}
+
+// Range returns a sequence over the entries whose keys fall in [from, to),
+// in ascending order, without copying them
+func (m *TreeMap[K, V]) Range(from, to K) iter.Seq2[K, V] {
+	return m.ascend(bound[K]{key: from, set: true, inclusive: true}, bound[K]{key: to, set: true})
+}
+
+func (m *TreeMap[K, V]) collect(seq iter.Seq2[K, V]) *TreeMap[K, V] {
+	collected := NewTreeMap[K, V](m.comparator)
+	for k, v := range seq {
+		collected.Put(k, v)
+	}
+	return collected
+}
+
+// bound limits a range walk; the zero value is unbounded
+type bound[K any] struct {
+	key       K
+	set       bool
+	inclusive bool
+}
+
+func (m *TreeMap[K, V]) ascend(lower, upper bound[K]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.walk(m.root, lower, upper, yield)
+	}
+}
+
+func (m *TreeMap[K, V]) walk(h *treeNode[K, V], lower, upper bound[K], yield func(K, V) bool) bool {
+	if h == nil {
+		return true
+	}
+
+	aboveLower := !lower.set || m.compare(h.key, lower.key) > 0 ||
+		(lower.inclusive && m.compare(h.key, lower.key) == 0)
+	belowUpper := !upper.set || m.compare(h.key, upper.key) < 0 ||
+		(upper.inclusive && m.compare(h.key, upper.key) == 0)
+
+	if aboveLower && !m.walk(h.left, lower, upper, yield) {
+		return false
+	}
+	if aboveLower && belowUpper && !yield(h.key, h.value) {
+		return false
+	}
+	if belowUpper && !m.walk(h.right, lower, upper, yield) {
+		return false
+	}
+	return true
+}
+
+func (m *TreeMap[K, V]) descend(h *treeNode[K, V], yield func(K, V) bool) bool {
+	if h == nil {
+		return true
+	}
+	return m.descend(h.right, yield) && yield(h.key, h.value) && m.descend(h.left, yield)
+}
+
+func isRed[K comparable, V any](h *treeNode[K, V]) bool {
+	return h != nil && h.red
+}
+
+func rotateLeft[K comparable, V any](h *treeNode[K, V]) *treeNode[K, V] {
+	x := h.right
+	h.right = x.left
+	x.left = h
+	x.red = h.red
+	h.red = true
+	return x
+}
+
+func rotateRight[K comparable, V any](h *treeNode[K, V]) *treeNode[K, V] {
+	x := h.left
+	h.left = x.right
+	x.right = h
+	x.red = h.red
+	h.red = true
+	return x
+}
+
+func flipColors[K comparable, V any](h *treeNode[K, V]) {
+	h.red = !h.red
+	h.left.red = !h.left.red
+	h.right.red = !h.right.red
+}
+
+func moveRedLeft[K comparable, V any](h *treeNode[K, V]) *treeNode[K, V] {
+	flipColors(h)
+	if isRed(h.right.left) {
+		h.right = rotateRight(h.right)
+		h = rotateLeft(h)
+		flipColors(h)
+	}
+	return h
+}
+
+func moveRedRight[K comparable, V any](h *treeNode[K, V]) *treeNode[K, V] {
+	flipColors(h)
+	if isRed(h.left.left) {
+		h = rotateRight(h)
+		flipColors(h)
+	}
+	return h
+}
+
+func deleteMin[K comparable, V any](h *treeNode[K, V]) *treeNode[K, V] {
+	if h.left == nil {
+		return nil
+	}
+	if !isRed(h.left) && !isRed(h.left.left) {
+		h = moveRedLeft(h)
+	}
+	h.left = deleteMin(h.left)
+	return balance(h)
+}
+
+// balance restores the left-leaning red-black invariants on the way up
+func balance[K comparable, V any](h *treeNode[K, V]) *treeNode[K, V] {
+	if isRed(h.right) && !isRed(h.left) {
+		h = rotateLeft(h)
+	}
+	if isRed(h.left) && isRed(h.left.left) {
+		h = rotateRight(h)
+	}
+	if isRed(h.left) && isRed(h.right) {
+		flipColors(h)
+	}
+	return h
+}
+
+func (m *TreeMap[K, V]) GetOrDefault(key K, fallback V) V {
+	return getOrDefault(m, key, fallback)
+}
+
+func (m *TreeMap[K, V]) Compute(key K, remapping func(key K, value V, present bool) (V, bool)) (V, bool) {
+	return compute(m, key, remapping)
+}
+
+func (m *TreeMap[K, V]) ComputeIfAbsent(key K, mapping func(key K) V) V {
+	return computeIfAbsent(m, key, mapping)
+}
+
+func (m *TreeMap[K, V]) ComputeIfPresent(key K, remapping func(key K, value V) (V, bool)) (V, bool) {
+	return computeIfPresent(m, key, remapping)
+}
+
+func (m *TreeMap[K, V]) Merge(key K, value V, remapping func(old, value V) (V, bool)) (V, bool) {
+	return merge(m, key, value, remapping)
+}
+
+func (m *TreeMap[K, V]) ReplaceAll(function func(K, V) V) {
+	var replace func(h *treeNode[K, V])
+	replace = func(h *treeNode[K, V]) {
+		if h == nil {
+			return
+		}
+		replace(h.left)
+		h.value = function(h.key, h.value)
+		replace(h.right)
+	}
+	replace(m.root)
+}
+
+func (m *TreeMap[K, V]) PutAll(other collection.Map[K, V]) {
+	putAll(m, other)
+}
+
+func (m *TreeMap[K, V]) RemoveIf(predicate func(K, V) bool) bool {
+	matched := make([]K, 0)
+	for k, v := range m.All() {
+		if predicate(k, v) {
+			matched = append(matched, k)
+		}
+	}
+	for _, k := range matched {
+		m.Delete(k)
+	}
+	return len(matched) > 0
+}
+
+func (m *TreeMap[K, V]) ForEach(action func(K, V)) {
+	for k, v := range m.All() {
+		action(k, v)
+	}
+}
@@ -0,0 +1,227 @@
+package maps_test
+
+import (
+	"math/rand"
+	"runtime"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/avila-r/ego/collection"
+	"github.com/avila-r/ego/function"
+	"github.com/avila-r/ego/maps"
+	"github.com/stretchr/testify/assert"
+)
+
+func treeOf(keys ...int) *maps.TreeMap[int, string] {
+	m := maps.NewOrderedTreeMap[int, string]()
+	for _, k := range keys {
+		m.Put(k, strings.Repeat("v", k))
+	}
+	return m
+}
+
+func TestTree_PutAndGet(t *testing.T) {
+	m := maps.NewOrderedTreeMap[string, int]()
+	m.Put("b", 2)
+	m.Put("a", 1)
+	m.Put("c", 3)
+	m.Put("b", 20)
+
+	value, ok := m.Get("b")
+	assert.True(t, ok)
+	assert.Equal(t, 20, value)
+
+	_, ok = m.Get("d")
+	assert.False(t, ok)
+
+	assert.Equal(t, 3, m.Len())
+	assert.Equal(t, []string{"a", "b", "c"}, m.KeySlice())
+	assert.Equal(t, []int{1, 20, 3}, m.ValueSlice())
+}
+
+func TestTree_PutIfAbsent(t *testing.T) {
+	m := treeOf(1)
+
+	assert.False(t, m.PutIfAbsent(1, "x"))
+	assert.True(t, m.PutIfAbsent(2, "x"))
+	assert.Equal(t, []string{"v", "x"}, m.ValueSlice())
+}
+
+func TestTree_Comparator(t *testing.T) {
+	byLength := function.NewComparator(func(a, b string) int {
+		return len(a) - len(b)
+	})
+	m := maps.NewTreeMap[string, int](byLength)
+	m.Put("ccc", 3)
+	m.Put("a", 1)
+	m.Put("bb", 2)
+	m.Put("zz", 20)
+
+	assert.Equal(t, []string{"a", "bb", "ccc"}, m.KeySlice())
+	assert.Equal(t, []int{1, 20, 3}, m.ValueSlice())
+}
+
+func TestTree_Delete(t *testing.T) {
+	m := treeOf(5, 3, 8, 1, 4)
+
+	m.Delete(3)
+	m.Delete(42)
+
+	assert.Equal(t, []int{1, 4, 5, 8}, m.KeySlice())
+	assert.False(t, m.ContainsKey(3))
+	assert.Equal(t, 4, m.Len())
+
+	for _, k := range []int{1, 4, 5, 8} {
+		m.Delete(k)
+	}
+	assert.True(t, m.IsEmpty())
+	assert.Equal(t, []int{}, m.KeySlice())
+}
+
+func TestTree_MatchesBuiltinMap(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	m := maps.NewOrderedTreeMap[int, int]()
+	expected := map[int]int{}
+
+	for i := range 5000 {
+		key := random.Intn(500)
+		if random.Intn(3) == 0 {
+			m.Delete(key)
+			delete(expected, key)
+		} else {
+			m.Put(key, i)
+			expected[key] = i
+		}
+	}
+
+	keys := make([]int, 0, len(expected))
+	for k := range expected {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	assert.Equal(t, len(expected), m.Len())
+	assert.Equal(t, keys, m.KeySlice())
+	assert.Equal(t, expected, m.Elements())
+}
+
+func TestTree_FirstAndLastKey(t *testing.T) {
+	m := treeOf(5, 3, 8)
+
+	first, _ := m.FirstKey()
+	last, _ := m.LastKey()
+	assert.Equal(t, 3, first)
+	assert.Equal(t, 8, last)
+
+	_, ok := treeOf().FirstKey()
+	assert.False(t, ok)
+	_, ok = treeOf().LastKey()
+	assert.False(t, ok)
+}
+
+func TestTree_Navigation(t *testing.T) {
+	m := treeOf(10, 20, 30)
+
+	type Case struct {
+		name     string
+		find     func(int) (collection.Entry[int, string], bool)
+		key      int
+		expected int
+		success  bool
+	}
+
+	cases := []Case{
+		{"floor exact", m.Floor, 20, 20, true},
+		{"floor between", m.Floor, 25, 20, true},
+		{"floor below all", m.Floor, 5, 0, false},
+		{"ceiling exact", m.Ceiling, 20, 20, true},
+		{"ceiling between", m.Ceiling, 25, 30, true},
+		{"ceiling above all", m.Ceiling, 35, 0, false},
+		{"lower exact", m.Lower, 20, 10, true},
+		{"lower below all", m.Lower, 10, 0, false},
+		{"higher exact", m.Higher, 20, 30, true},
+		{"higher above all", m.Higher, 30, 0, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			entry, ok := c.find(c.key)
+			assert.Equal(t, c.success, ok)
+			assert.Equal(t, c.expected, entry.Key)
+		})
+	}
+}
+
+func TestTree_Ranges(t *testing.T) {
+	m := treeOf(1, 2, 3, 4, 5)
+
+	assert.Equal(t, []int{1, 2}, m.HeadMap(3).KeySlice())
+	assert.Equal(t, []int{3, 4, 5}, m.TailMap(3).KeySlice())
+	assert.Equal(t, []int{2, 3}, m.SubMap(2, 4).KeySlice())
+	assert.Equal(t, []int{}, m.SubMap(4, 4).KeySlice())
+
+	keys := []int{}
+	for k := range m.Range(2, 10) {
+		keys = append(keys, k)
+	}
+	assert.Equal(t, []int{2, 3, 4, 5}, keys)
+
+	sub := m.SubMap(2, 4)
+	sub.Put(9, "x")
+	assert.False(t, m.ContainsKey(9))
+}
+
+func TestTree_Descending(t *testing.T) {
+	m := treeOf(2, 1, 3)
+
+	keys := []int{}
+	for k := range m.Backward() {
+		keys = append(keys, k)
+	}
+	assert.Equal(t, []int{3, 2, 1}, keys)
+
+	it := m.DescendingIterator()
+	assert.Equal(t, 3, it.Next().Key)
+	assert.Equal(t, 2, it.Next().Key)
+}
+
+func TestTree_Iterator(t *testing.T) {
+	m := treeOf(2, 1)
+
+	expected := []collection.Entry[int, string]{{Key: 1, Value: "v"}, {Key: 2, Value: "vv"}}
+	assert.Equal(t, expected, m.Iterator().Collect())
+	assert.Equal(t, expected, m.ToSlice())
+}
+
+func TestTree_IteratorIsCursor(t *testing.T) {
+	m := treeOf(1, 2, 3)
+	before := runtime.NumGoroutine()
+
+	it := m.Iterator()
+	assert.Equal(t, 1, it.Next().Key)
+	assert.Equal(t, before, runtime.NumGoroutine())
+
+	// Each step looks up the successor of the last key, so the iterator
+	// follows later changes to the map
+	m.Delete(2)
+	m.Put(4, "vvvv")
+	assert.Equal(t, []int{3, 4}, []int{it.Next().Key, it.Next().Key})
+	assert.False(t, it.HasNext())
+
+	it.Reset()
+	assert.Len(t, it.Collect(), 3)
+}
+
+func TestTree_FilterAndClone(t *testing.T) {
+	m := treeOf(1, 2, 3, 4)
+
+	evens := m.Filter(func(k int, _ string) bool { return k%2 == 0 })
+	assert.Equal(t, []int{2, 4}, evens.KeySlice())
+
+	cloned := m.Clone()
+	cloned.Delete(1)
+	assert.True(t, m.ContainsKey(1))
+	assert.True(t, m.ContainsValue("vvv"))
+	assert.False(t, m.ContainsValue("x"))
+}
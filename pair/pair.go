@@ -4,3 +4,14 @@ type EntryPair[K comparable, V any] struct {
 	Key   K
 	Value V
 }
+
+// Pair holds two values of possibly different types
+type Pair[F, S any] struct {
+	First  F
+	Second S
+}
+
+// Of creates a Pair from its two values
+func Of[F, S any](first F, second S) Pair[F, S] {
+	return Pair[F, S]{First: first, Second: second}
+}
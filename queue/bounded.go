@@ -0,0 +1,84 @@
+package queue
+
+import (
+	"slices"
+
+	"github.com/avila-r/ego/function"
+)
+
+// Bounded keeps the best n elements offered to it, evicting the worst one
+// when it is full. Memory stays O(n) no matter how many elements are offered.
+type Bounded[T any] struct {
+	queue    *PriorityQueue[T]
+	capacity int
+	compare  func(a, b T) int
+}
+
+// NewMaxBounded keeps the n greatest elements according to comparator
+func NewMaxBounded[T any](n int, comparator function.Comparator[T]) *Bounded[T] {
+	return newBounded(n, comparator)
+}
+
+// NewMinBounded keeps the n smallest elements according to comparator
+func NewMinBounded[T any](n int, comparator function.Comparator[T]) *Bounded[T] {
+	return newBounded(n, function.NewComparator(func(a, b T) int {
+		return comparator.Compare(b, a)
+	}))
+}
+
+// newBounded keeps the n greatest elements by comparator; its queue holds
+// the weakest kept element at the root
+func newBounded[T any](n int, comparator function.Comparator[T]) *Bounded[T] {
+	if n <= 0 {
+		ErrNonPositiveCapacity.Panic()
+	}
+	return &Bounded[T]{
+		queue:    NewPriorityQueue(comparator),
+		capacity: n,
+		compare:  comparator.Compare,
+	}
+}
+
+// Offer records value and reports whether it is currently among the kept
+// elements. When full, value replaces the weakest element only if it is
+// strictly better, so earlier elements win ties.
+func (b *Bounded[T]) Offer(value T) bool {
+	if b.queue.Len() < b.capacity {
+		b.queue.Push(value)
+		return true
+	}
+
+	weakest, _ := b.queue.Peek()
+	if b.compare(value, weakest) <= 0 {
+		return false
+	}
+	b.queue.Update(b.queue.heap.items[0], value)
+	return true
+}
+
+// Weakest returns the kept element that would be evicted next
+func (b *Bounded[T]) Weakest() (T, bool) {
+	return b.queue.Peek()
+}
+
+func (b *Bounded[T]) Len() int {
+	return b.queue.Len()
+}
+
+// Cap returns the maximum number of kept elements
+func (b *Bounded[T]) Cap() int {
+	return b.capacity
+}
+
+func (b *Bounded[T]) Clear() {
+	b.queue.Clear()
+}
+
+// Sorted returns the kept elements, best first
+func (b *Bounded[T]) Sorted() []T {
+	elements := b.queue.Elements()
+	slices.SortFunc(elements, func(x, y T) int {
+		return b.compare(y, x)
+	})
+	return elements
+}
@@ -0,0 +1,64 @@
+package queue_test
+
+import (
+	"testing"
+
+	"github.com/avila-r/ego/function"
+	"github.com/avila-r/ego/queue"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_MaxBounded(t *testing.T) {
+	top := queue.NewMaxBounded(3, ascending)
+	for _, v := range []int{5, 1, 9, 3, 7, 2, 8} {
+		top.Offer(v)
+	}
+
+	assert.Equal(t, 3, top.Len())
+	assert.Equal(t, 3, top.Cap())
+	assert.Equal(t, []int{9, 8, 7}, top.Sorted())
+
+	weakest, _ := top.Weakest()
+	assert.Equal(t, 7, weakest)
+	assert.False(t, top.Offer(6))
+	assert.True(t, top.Offer(10))
+	assert.Equal(t, []int{10, 9, 8}, top.Sorted())
+}
+
+func Test_MinBounded(t *testing.T) {
+	bottom := queue.NewMinBounded(2, ascending)
+	for _, v := range []int{5, 1, 9, 3} {
+		bottom.Offer(v)
+	}
+
+	assert.Equal(t, []int{1, 3}, bottom.Sorted())
+}
+
+func Test_Bounded_KeepsEarliestTies(t *testing.T) {
+	type score struct {
+		name   string
+		points int
+	}
+	byPoints := function.NewComparator(func(a, b score) int { return a.points - b.points })
+
+	top := queue.NewMaxBounded(2, byPoints)
+	top.Offer(score{"ann", 10})
+	top.Offer(score{"bob", 5})
+	assert.False(t, top.Offer(score{"cid", 5}))
+
+	assert.Equal(t, []score{{"ann", 10}, {"bob", 5}}, top.Sorted())
+}
+
+func Test_Bounded_InvalidCapacity(t *testing.T) {
+	assert.Panics(t, func() { queue.NewMaxBounded(0, ascending) })
+	assert.Panics(t, func() { queue.NewMinBounded(-1, ascending) })
+}
+
+func Test_Bounded_Clear(t *testing.T) {
+	top := queue.NewMaxBounded(2, ascending)
+	top.Offer(1)
+	top.Clear()
+
+	assert.Equal(t, 0, top.Len())
+	assert.Equal(t, []int{}, top.Sorted())
+}
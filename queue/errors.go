@@ -0,0 +1,11 @@
+package queue
+
+import (
+	"github.com/avila-r/ego"
+)
+
+var errors = ego.ExtendedGoErrorsNamespace.Class("queue")
+
+var (
+	ErrNonPositiveCapacity = errors.New("capacity must be positive")
+)
@@ -0,0 +1,174 @@
+package queue
+
+import (
+	"container/heap"
+	"iter"
+
+	"github.com/avila-r/ego/function"
+)
+
+// Handle refers to an element pushed into a PriorityQueue. It stays valid
+// until the element is popped or removed, and lets callers change the
+// element's priority in place.
+type Handle[T any] struct {
+	value T
+	index int
+	owner *PriorityQueue[T]
+}
+
+// Value returns the element the handle refers to
+func (h *Handle[T]) Value() T {
+	return h.value
+}
+
+// Queued reports whether the element is still in its queue
+func (h *Handle[T]) Queued() bool {
+	return h.owner != nil
+}
+
+// PriorityQueue is a binary min-heap ordered by a comparator: Pop always
+// returns the smallest element. Use a reversed comparator for a max-heap.
+//
+// Push, Pop, Update and Remove are O(log n); Peek and Len are O(1).
+type PriorityQueue[T any] struct {
+	heap handles[T]
+}
+
+// NewPriorityQueue creates an empty queue ordered by comparator
+func NewPriorityQueue[T any](comparator function.Comparator[T]) *PriorityQueue[T] {
+	return &PriorityQueue[T]{
+		heap: handles[T]{compare: comparator.Compare},
+	}
+}
+
+// Heapify creates a queue holding elements in O(n)
+func Heapify[T any](comparator function.Comparator[T], elements ...T) *PriorityQueue[T] {
+	q := NewPriorityQueue(comparator)
+	q.heap.items = make([]*Handle[T], len(elements))
+	for i, element := range elements {
+		q.heap.items[i] = &Handle[T]{value: element, index: i, owner: q}
+	}
+	heap.Init(&q.heap)
+	return q
+}
+
+// Push adds value to the queue and returns its handle
+func (q *PriorityQueue[T]) Push(value T) *Handle[T] {
+	handle := &Handle[T]{value: value, owner: q}
+	heap.Push(&q.heap, handle)
+	return handle
+}
+
+// Pop removes and returns the smallest element
+func (q *PriorityQueue[T]) Pop() (T, bool) {
+	if len(q.heap.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	return heap.Pop(&q.heap).(*Handle[T]).value, true
+}
+
+// Peek returns the smallest element without removing it
+func (q *PriorityQueue[T]) Peek() (T, bool) {
+	if len(q.heap.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	return q.heap.items[0].value, true
+}
+
+// Update replaces the element behind handle and restores the heap order. It
+// reports false if the handle doesn't belong to this queue anymore.
+func (q *PriorityQueue[T]) Update(handle *Handle[T], value T) bool {
+	if handle.owner != q {
+		return false
+	}
+	handle.value = value
+	heap.Fix(&q.heap, handle.index)
+	return true
+}
+
+// Remove takes the element behind handle out of the queue. It reports false
+// if the handle doesn't belong to this queue anymore.
+func (q *PriorityQueue[T]) Remove(handle *Handle[T]) bool {
+	if handle.owner != q {
+		return false
+	}
+	heap.Remove(&q.heap, handle.index)
+	return true
+}
+
+func (q *PriorityQueue[T]) Len() int {
+	return len(q.heap.items)
+}
+
+func (q *PriorityQueue[T]) IsEmpty() bool {
+	return len(q.heap.items) == 0
+}
+
+// Clear removes every element, invalidating all handles
+func (q *PriorityQueue[T]) Clear() {
+	for _, handle := range q.heap.items {
+		handle.owner = nil
+	}
+	q.heap.items = nil
+}
+
+// All returns a sequence over the elements in heap order, which is not
+// sorted order
+func (q *PriorityQueue[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, handle := range q.heap.items {
+			if !yield(handle.value) {
+				return
+			}
+		}
+	}
+}
+
+// Elements returns the elements in heap order
+func (q *PriorityQueue[T]) Elements() []T {
+	elements := make([]T, 0, len(q.heap.items))
+	for element := range q.All() {
+		elements = append(elements, element)
+	}
+	return elements
+}
+
+// handles implements heap.Interface over the queue's handles, keeping each
+// handle's index in sync with its position
+type handles[T any] struct {
+	items   []*Handle[T]
+	compare func(a, b T) int
+}
+
+func (h *handles[T]) Len() int {
+	return len(h.items)
+}
+
+func (h *handles[T]) Less(i, j int) bool {
+	return h.compare(h.items[i].value, h.items[j].value) < 0
+}
+
+func (h *handles[T]) Swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+	h.items[i].index = i
+	h.items[j].index = j
+}
+
+func (h *handles[T]) Push(x any) {
+	handle := x.(*Handle[T])
+	handle.index = len(h.items)
+	h.items = append(h.items, handle)
+}
+
+func (h *handles[T]) Pop() any {
+	last := len(h.items) - 1
+	handle := h.items[last]
+	h.items[last] = nil
+	h.items = h.items[:last]
+
+	handle.index = -1
+	handle.owner = nil
+	return handle
+}
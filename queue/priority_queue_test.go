@@ -0,0 +1,154 @@
+package queue_test
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/avila-r/ego/function"
+	"github.com/avila-r/ego/queue"
+	"github.com/stretchr/testify/assert"
+)
+
+var ascending = function.NewComparator(func(a, b int) int { return a - b })
+
+func drain[T any](q *queue.PriorityQueue[T]) []T {
+	elements := []T{}
+	for !q.IsEmpty() {
+		element, _ := q.Pop()
+		elements = append(elements, element)
+	}
+	return elements
+}
+
+func Test_PushPop(t *testing.T) {
+	q := queue.NewPriorityQueue(ascending)
+	for _, v := range []int{5, 1, 4, 1, 3} {
+		q.Push(v)
+	}
+
+	peeked, ok := q.Peek()
+	assert.True(t, ok)
+	assert.Equal(t, 1, peeked)
+	assert.Equal(t, 5, q.Len())
+
+	assert.Equal(t, []int{1, 1, 3, 4, 5}, drain(q))
+
+	_, ok = q.Pop()
+	assert.False(t, ok)
+	_, ok = q.Peek()
+	assert.False(t, ok)
+}
+
+func Test_MaxHeap(t *testing.T) {
+	descending := function.NewComparator(func(a, b int) int { return b - a })
+	q := queue.Heapify(descending, 2, 9, 4)
+
+	assert.Equal(t, []int{9, 4, 2}, drain(q))
+}
+
+func Test_Heapify(t *testing.T) {
+	elements := rand.New(rand.NewSource(3)).Perm(200)
+	q := queue.Heapify(ascending, elements...)
+
+	assert.Equal(t, 200, q.Len())
+	assert.ElementsMatch(t, elements, q.Elements())
+
+	sorted := slices.Clone(elements)
+	slices.Sort(sorted)
+	assert.Equal(t, sorted, drain(q))
+}
+
+func Test_Update(t *testing.T) {
+	q := queue.NewPriorityQueue(ascending)
+	q.Push(10)
+	handle := q.Push(20)
+	q.Push(30)
+
+	assert.True(t, q.Update(handle, 5))
+	assert.Equal(t, 5, handle.Value())
+	peeked, _ := q.Peek()
+	assert.Equal(t, 5, peeked)
+
+	assert.True(t, q.Update(handle, 40))
+	assert.Equal(t, []int{10, 30, 40}, drain(q))
+
+	assert.False(t, handle.Queued())
+	assert.False(t, q.Update(handle, 1))
+}
+
+func Test_Remove(t *testing.T) {
+	q := queue.NewPriorityQueue(ascending)
+	handles := []*queue.Handle[int]{}
+	for _, v := range []int{3, 1, 4, 1, 5, 9, 2, 6} {
+		handles = append(handles, q.Push(v))
+	}
+
+	assert.True(t, q.Remove(handles[2]))
+	assert.True(t, q.Remove(handles[1]))
+	assert.False(t, q.Remove(handles[1]))
+
+	assert.Equal(t, []int{1, 2, 3, 5, 6, 9}, drain(q))
+}
+
+func Test_HandleFromOtherQueue(t *testing.T) {
+	a := queue.NewPriorityQueue(ascending)
+	b := queue.NewPriorityQueue(ascending)
+	handle := a.Push(1)
+
+	assert.False(t, b.Update(handle, 2))
+	assert.False(t, b.Remove(handle))
+	assert.Equal(t, 1, a.Len())
+}
+
+func Test_Clear(t *testing.T) {
+	q := queue.NewPriorityQueue(ascending)
+	handle := q.Push(1)
+	q.Clear()
+
+	assert.True(t, q.IsEmpty())
+	assert.False(t, handle.Queued())
+	assert.False(t, q.Remove(handle))
+}
+
+func Test_Dijkstra(t *testing.T) {
+	type node struct {
+		id       string
+		distance int
+	}
+	graph := map[string]map[string]int{
+		"a": {"b": 7, "c": 9, "f": 14},
+		"b": {"a": 7, "c": 10, "d": 15},
+		"c": {"a": 9, "b": 10, "d": 11, "f": 2},
+		"d": {"b": 15, "c": 11, "e": 6},
+		"e": {"d": 6, "f": 9},
+		"f": {"a": 14, "c": 2, "e": 9},
+	}
+
+	q := queue.NewPriorityQueue(function.NewComparator(func(x, y node) int {
+		return x.distance - y.distance
+	}))
+	handles := map[string]*queue.Handle[node]{"a": q.Push(node{"a", 0})}
+	distances := map[string]int{}
+
+	for !q.IsEmpty() {
+		current, _ := q.Pop()
+		distances[current.id] = current.distance
+
+		for neighbor, weight := range graph[current.id] {
+			if _, done := distances[neighbor]; done {
+				continue
+			}
+			candidate := node{neighbor, current.distance + weight}
+			if handle, ok := handles[neighbor]; ok && handle.Queued() {
+				if candidate.distance < handle.Value().distance {
+					q.Update(handle, candidate)
+				}
+				continue
+			}
+			handles[neighbor] = q.Push(candidate)
+		}
+	}
+
+	assert.Equal(t, map[string]int{"a": 0, "b": 7, "c": 9, "d": 20, "e": 20, "f": 11}, distances)
+}
@@ -0,0 +1,149 @@
+package set
+
+import (
+	"slices"
+	"testing"
+)
+
+// implementations returns a constructor for every set type, each filling
+// the set with the given elements
+func implementations() map[string]func(...int) Settable[int] {
+	fill := func(s Settable[int], elements []int) Settable[int] {
+		for _, element := range elements {
+			s.Add(element)
+		}
+		return s
+	}
+
+	return map[string]func(...int) Settable[int]{
+		"Set":           func(e ...int) Settable[int] { return NewSet(e) },
+		"HashSet":       func(e ...int) Settable[int] { return fill(NewHashSet[int](), e) },
+		"LinkedHashSet": func(e ...int) Settable[int] { return fill(NewLinkedHashSet[int](), e) },
+		"TreeSet":       func(e ...int) Settable[int] { return fill(NewOrderedTreeSet[int](), e) },
+	}
+}
+
+func sorted(s Settable[int]) []int {
+	elements := s.ToSlice()
+	slices.Sort(elements)
+	return elements
+}
+
+func TestSymmetricDifference(t *testing.T) {
+	for name, of := range implementations() {
+		t.Run(name, func(t *testing.T) {
+			result := of(1, 2, 3).SymmetricDifference(NewSet([]int{3, 4}))
+
+			if got := sorted(result); !slices.Equal(got, []int{1, 2, 4}) {
+				t.Errorf("Expected [1 2 4], got %v", got)
+			}
+			if got := sorted(of().SymmetricDifference(of())); len(got) != 0 {
+				t.Errorf("Expected empty result, got %v", got)
+			}
+		})
+	}
+}
+
+func TestSubsetRelations(t *testing.T) {
+	tests := []struct {
+		name               string
+		a, b               []int
+		subset, superset   bool
+		disjoint, equality bool
+	}{
+		{"equal", []int{1, 2}, []int{2, 1}, true, true, false, true},
+		{"proper subset", []int{1}, []int{1, 2}, true, false, false, false},
+		{"proper superset", []int{1, 2, 3}, []int{2}, false, true, false, false},
+		{"overlapping", []int{1, 2}, []int{2, 3}, false, false, false, false},
+		{"disjoint", []int{1}, []int{2}, false, false, true, false},
+		{"empty", nil, []int{1}, true, false, true, false},
+		{"both empty", nil, nil, true, true, true, true},
+	}
+
+	for name, of := range implementations() {
+		for _, tt := range tests {
+			t.Run(name+"/"+tt.name, func(t *testing.T) {
+				a := of(tt.a...)
+				// The other operand is a different kind of set on purpose
+				b := NewHashSet[int]()
+				for _, e := range tt.b {
+					b.Add(e)
+				}
+
+				if got := a.IsSubsetOf(b); got != tt.subset {
+					t.Errorf("IsSubsetOf: expected %v, got %v", tt.subset, got)
+				}
+				if got := a.IsSupersetOf(b); got != tt.superset {
+					t.Errorf("IsSupersetOf: expected %v, got %v", tt.superset, got)
+				}
+				if got := a.IsDisjoint(b); got != tt.disjoint {
+					t.Errorf("IsDisjoint: expected %v, got %v", tt.disjoint, got)
+				}
+				if got := a.Equals(b); got != tt.equality {
+					t.Errorf("Equals: expected %v, got %v", tt.equality, got)
+				}
+			})
+		}
+	}
+}
+
+func TestEqualsNil(t *testing.T) {
+	for name, of := range implementations() {
+		t.Run(name, func(t *testing.T) {
+			if of().Equals(nil) {
+				t.Error("Expected a set not to equal nil")
+			}
+		})
+	}
+}
+
+func TestSetEqualsIsStructural(t *testing.T) {
+	single := NewSet([]int{1})
+
+	if !single.Equals(NewSet([]int{1})) {
+		t.Error("Expected sets with the same element to be equal")
+	}
+	if single.Equals(NewSet([]int{1, 2})) {
+		t.Error("Expected sets with different elements not to be equal")
+	}
+}
+
+func TestIterationInterfaces(t *testing.T) {
+	for name, of := range implementations() {
+		t.Run(name, func(t *testing.T) {
+			s := of(3, 1, 2)
+
+			streamed := s.Stream().ToSlice()
+			slices.Sort(streamed)
+			if !slices.Equal(streamed, []int{1, 2, 3}) {
+				t.Errorf("Stream: expected [1 2 3], got %v", streamed)
+			}
+
+			iterated := s.Iterator().Collect()
+			slices.Sort(iterated)
+			if !slices.Equal(iterated, []int{1, 2, 3}) {
+				t.Errorf("Iterator: expected [1 2 3], got %v", iterated)
+			}
+
+			sum := 0
+			s.ForEach(func(e int) { sum += e })
+			if sum != 6 {
+				t.Errorf("ForEach: expected sum 6, got %d", sum)
+			}
+		})
+	}
+}
+
+func TestResultKeepsKind(t *testing.T) {
+	tree := NewOrderedTreeSet[int]()
+	tree.Add(5)
+	tree.Add(1)
+
+	result := tree.SymmetricDifference(NewSet([]int{3}))
+	if _, ok := result.(*TreeSet[int]); !ok {
+		t.Fatalf("Expected a *TreeSet, got %T", result)
+	}
+	if got := result.ToSlice(); !slices.Equal(got, []int{1, 3, 5}) {
+		t.Errorf("Expected sorted [1 3 5], got %v", got)
+	}
+}
@@ -0,0 +1,214 @@
+package set
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"reflect"
+	"sync"
+
+	"github.com/avila-r/ego/function"
+)
+
+// comparators maps an element type to the function.Comparator that orders
+// decoded TreeSets of it
+var comparators sync.Map
+
+// RegisterComparator sets the order of TreeSets of E decoded into a zero
+// value. Without one, element types with a natural order (integers,
+// floats and strings) use it.
+func RegisterComparator[E comparable](comparator function.Comparator[E]) {
+	comparators.Store(reflect.TypeFor[E](), comparator)
+}
+
+func comparatorFor[E comparable]() (function.Comparator[E], bool) {
+	if registered, ok := comparators.Load(reflect.TypeFor[E]()); ok {
+		return registered.(function.Comparator[E]), true
+	}
+	return function.NaturalOrderOf[E]()
+}
+
+func marshalJSON[E comparable](s Settable[E]) ([]byte, error) {
+	return json.Marshal(s.ToSlice())
+}
+
+func marshalBinary[E comparable](s Settable[E]) ([]byte, error) {
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(s.ToSlice()); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+func unmarshalJSON[E comparable](data []byte) ([]E, error) {
+	var elements []E
+	err := json.Unmarshal(data, &elements)
+	return elements, err
+}
+
+func unmarshalBinary[E comparable](data []byte) ([]E, error) {
+	var elements []E
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(&elements)
+	return elements, err
+}
+
+// MarshalJSON encodes the set as a JSON array
+func (s *Set[E]) MarshalJSON() ([]byte, error) {
+	return marshalJSON[E](s)
+}
+
+// UnmarshalJSON replaces the set's contents with a JSON array, dropping
+// duplicates
+func (s *Set[E]) UnmarshalJSON(data []byte) error {
+	elements, err := unmarshalJSON[E](data)
+	if err != nil {
+		return err
+	}
+	*s = *NewSet(elements)
+	return nil
+}
+
+// MarshalBinary encodes the set with encoding/gob
+func (s *Set[E]) MarshalBinary() ([]byte, error) {
+	return marshalBinary[E](s)
+}
+
+// UnmarshalBinary replaces the set's contents with gob-encoded data
+func (s *Set[E]) UnmarshalBinary(data []byte) error {
+	elements, err := unmarshalBinary[E](data)
+	if err != nil {
+		return err
+	}
+	*s = *NewSet(elements)
+	return nil
+}
+
+// MarshalJSON encodes the set as a JSON array in unspecified order
+func (s *HashSet[E]) MarshalJSON() ([]byte, error) {
+	return marshalJSON[E](s)
+}
+
+// UnmarshalJSON replaces the set's contents with a JSON array, dropping
+// duplicates
+func (s *HashSet[E]) UnmarshalJSON(data []byte) error {
+	elements, err := unmarshalJSON[E](data)
+	if err != nil {
+		return err
+	}
+	s.fill(elements)
+	return nil
+}
+
+// MarshalBinary encodes the set with encoding/gob
+func (s *HashSet[E]) MarshalBinary() ([]byte, error) {
+	return marshalBinary[E](s)
+}
+
+// UnmarshalBinary replaces the set's contents with gob-encoded data
+func (s *HashSet[E]) UnmarshalBinary(data []byte) error {
+	elements, err := unmarshalBinary[E](data)
+	if err != nil {
+		return err
+	}
+	s.fill(elements)
+	return nil
+}
+
+func (s *HashSet[E]) fill(elements []E) {
+	s.data = make(map[E]struct{}, len(elements))
+	for _, element := range elements {
+		s.data[element] = struct{}{}
+	}
+}
+
+// MarshalJSON encodes the set as a JSON array in insertion order
+func (s *LinkedHashSet[E]) MarshalJSON() ([]byte, error) {
+	return marshalJSON[E](s)
+}
+
+// UnmarshalJSON replaces the set's contents with a JSON array, keeping the
+// first occurrence of each element in document order
+func (s *LinkedHashSet[E]) UnmarshalJSON(data []byte) error {
+	elements, err := unmarshalJSON[E](data)
+	if err != nil {
+		return err
+	}
+	s.fill(elements)
+	return nil
+}
+
+// MarshalBinary encodes the set, in insertion order, with encoding/gob
+func (s *LinkedHashSet[E]) MarshalBinary() ([]byte, error) {
+	return marshalBinary[E](s)
+}
+
+// UnmarshalBinary replaces the set's contents with gob-encoded data
+func (s *LinkedHashSet[E]) UnmarshalBinary(data []byte) error {
+	elements, err := unmarshalBinary[E](data)
+	if err != nil {
+		return err
+	}
+	s.fill(elements)
+	return nil
+}
+
+func (s *LinkedHashSet[E]) fill(elements []E) {
+	s.Clear()
+	for _, element := range elements {
+		s.Add(element)
+	}
+}
+
+// MarshalJSON encodes the set as a JSON array in ascending order
+func (s *TreeSet[E]) MarshalJSON() ([]byte, error) {
+	if s.tree == nil {
+		return []byte("[]"), nil
+	}
+	return marshalJSON[E](s)
+}
+
+// UnmarshalJSON replaces the set's contents with a JSON array. A zero
+// TreeSet takes its order from RegisterComparator.
+func (s *TreeSet[E]) UnmarshalJSON(data []byte) error {
+	elements, err := unmarshalJSON[E](data)
+	if err != nil {
+		return err
+	}
+	return s.fill(elements)
+}
+
+// MarshalBinary encodes the set, in ascending order, with encoding/gob
+func (s *TreeSet[E]) MarshalBinary() ([]byte, error) {
+	if s.tree == nil {
+		return marshalBinary[E](NewSet[E](nil))
+	}
+	return marshalBinary[E](s)
+}
+
+// UnmarshalBinary replaces the set's contents with gob-encoded data. A zero
+// TreeSet takes its order from RegisterComparator.
+func (s *TreeSet[E]) UnmarshalBinary(data []byte) error {
+	elements, err := unmarshalBinary[E](data)
+	if err != nil {
+		return err
+	}
+	return s.fill(elements)
+}
+
+func (s *TreeSet[E]) fill(elements []E) error {
+	comparator := s.comparator
+	if comparator == nil {
+		found, ok := comparatorFor[E]()
+		if !ok {
+			return ErrNoComparator
+		}
+		comparator = found
+	}
+
+	decoded := NewTreeSetWith(comparator)
+	for _, element := range elements {
+		decoded.Add(element)
+	}
+	*s = *decoded
+	return nil
+}
@@ -0,0 +1,151 @@
+package set
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/avila-r/ego/function"
+)
+
+func TestSetsJSONRoundTrip(t *testing.T) {
+	for name, of := range implementations() {
+		t.Run(name, func(t *testing.T) {
+			original := of(3, 1, 2)
+
+			encoded, err := json.Marshal(original)
+			if err != nil {
+				t.Fatalf("Marshal failed: %v", err)
+			}
+
+			decoded := of(9)
+			if err := json.Unmarshal(encoded, decoded); err != nil {
+				t.Fatalf("Unmarshal failed: %v", err)
+			}
+			if !decoded.Equals(original) {
+				t.Errorf("Expected %v after round trip, got %v", original.ToSlice(), decoded.ToSlice())
+			}
+		})
+	}
+}
+
+func TestSetsGobRoundTrip(t *testing.T) {
+	for name, of := range implementations() {
+		t.Run(name, func(t *testing.T) {
+			original := of(3, 1, 2)
+
+			var buffer bytes.Buffer
+			if err := gob.NewEncoder(&buffer).Encode(original); err != nil {
+				t.Fatalf("Encode failed: %v", err)
+			}
+
+			decoded := of()
+			if err := gob.NewDecoder(&buffer).Decode(decoded); err != nil {
+				t.Fatalf("Decode failed: %v", err)
+			}
+			if !decoded.Equals(original) {
+				t.Errorf("Expected %v after round trip, got %v", original.ToSlice(), decoded.ToSlice())
+			}
+		})
+	}
+}
+
+func TestSetsJSONOrder(t *testing.T) {
+	linked := NewLinkedHashSet[string]()
+	tree := NewOrderedTreeSet[string]()
+	for _, word := range []string{"pear", "apple", "fig"} {
+		linked.Add(word)
+		tree.Add(word)
+	}
+
+	tests := []struct {
+		name     string
+		set      Settable[string]
+		expected string
+	}{
+		{"linked keeps insertion order", linked, `["pear","apple","fig"]`},
+		{"tree is sorted", tree, `["apple","fig","pear"]`},
+	}
+
+	for _, tt := range tests {
+		encoded, err := json.Marshal(tt.set)
+		if err != nil {
+			t.Fatalf("%s: Marshal failed: %v", tt.name, err)
+		}
+		if string(encoded) != tt.expected {
+			t.Errorf("%s: expected %s, got %s", tt.name, tt.expected, encoded)
+		}
+	}
+
+	decoded := NewLinkedHashSet[string]()
+	if err := json.Unmarshal([]byte(`["b","a","b","c"]`), decoded); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if got := decoded.ToSlice(); !slices.Equal(got, []string{"b", "a", "c"}) {
+		t.Errorf("Expected first occurrences in document order, got %v", got)
+	}
+}
+
+func TestTreeSetJSONInStruct(t *testing.T) {
+	type DTO struct {
+		Scores TreeSet[int] `json:"scores"`
+	}
+
+	var dto DTO
+	if err := json.Unmarshal([]byte(`{"scores":[30,10,20]}`), &dto); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if got := dto.Scores.ToSlice(); !slices.Equal(got, []int{10, 20, 30}) {
+		t.Errorf("Expected natural order, got %v", got)
+	}
+
+	encoded, err := json.Marshal(&dto)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if string(encoded) != `{"scores":[10,20,30]}` {
+		t.Errorf("Unexpected encoding %s", encoded)
+	}
+}
+
+type caseless string
+
+func TestTreeSetRegisteredComparator(t *testing.T) {
+	RegisterComparator(function.NewComparator(func(a, b caseless) int {
+		return strings.Compare(strings.ToLower(string(a)), strings.ToLower(string(b)))
+	}))
+
+	var decoded TreeSet[caseless]
+	if err := json.Unmarshal([]byte(`["b","A","a","C"]`), &decoded); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if got := decoded.ToSlice(); !slices.Equal(got, []caseless{"A", "b", "C"}) {
+		t.Errorf("Expected case-insensitive order without duplicates, got %v", got)
+	}
+}
+
+func TestTreeSetKeepsOwnComparator(t *testing.T) {
+	descending := NewTreeSet(func(a, b int) bool { return a > b })
+	if err := json.Unmarshal([]byte(`[1,3,2]`), descending); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if got := descending.ToSlice(); !slices.Equal(got, []int{3, 2, 1}) {
+		t.Errorf("Expected the set's own descending order, got %v", got)
+	}
+}
+
+func TestTreeSetWithoutComparator(t *testing.T) {
+	type point struct{ X, Y int }
+
+	var decoded TreeSet[point]
+	err := json.Unmarshal([]byte(`[{"X":1,"Y":2}]`), &decoded)
+	if err == nil {
+		t.Fatal("Expected an error for an element type without an order")
+	}
+	if !strings.Contains(err.Error(), ErrNoComparator.Error()) {
+		t.Errorf("Expected ErrNoComparator, got %v", err)
+	}
+}
@@ -0,0 +1,189 @@
+package set
+
+import (
+	"bytes"
+	"iter"
+	"slices"
+
+	"github.com/avila-r/ego/collection"
+	"github.com/avila-r/ego/iterator"
+	"github.com/avila-r/ego/maps"
+	"github.com/avila-r/ego/stream"
+)
+
+// CustomHashSet is a hash set that hashes and compares elements with the
+// given functions instead of Go's built-in equality, so it can hold slices
+// or values whose equality differs from ==. Iteration order is unspecified.
+type CustomHashSet[E any] struct {
+	buckets map[uint64][]E
+	size    int
+	hasher  func(E) uint64
+	equal   func(a, b E) bool
+}
+
+var _ collection.Set[[]byte] = (*CustomHashSet[[]byte])(nil)
+
+// NewCustomHashSet creates an empty set. Elements that are equal must have
+// the same hash.
+func NewCustomHashSet[E any](hasher func(E) uint64, equal func(a, b E) bool) *CustomHashSet[E] {
+	return &CustomHashSet[E]{
+		buckets: make(map[uint64][]E),
+		hasher:  hasher,
+		equal:   equal,
+	}
+}
+
+// NewBytesHashSet creates an empty set of byte slices compared by content
+func NewBytesHashSet() *CustomHashSet[[]byte] {
+	return NewCustomHashSet(maps.HashBytes, bytes.Equal)
+}
+
+// NewCaseInsensitiveHashSet creates an empty set of strings compared
+// ignoring case. The first spelling added is the one kept.
+func NewCaseInsensitiveHashSet() *CustomHashSet[string] {
+	return NewCustomHashSet(maps.HashFold, maps.EqualFold)
+}
+
+func (s *CustomHashSet[E]) find(element E) (uint64, int) {
+	hash := s.hasher(element)
+	for i, candidate := range s.buckets[hash] {
+		if s.equal(candidate, element) {
+			return hash, i
+		}
+	}
+	return hash, -1
+}
+
+func (s *CustomHashSet[E]) empty() *CustomHashSet[E] {
+	return NewCustomHashSet(s.hasher, s.equal)
+}
+
+func (s *CustomHashSet[E]) Add(element E) bool {
+	hash, i := s.find(element)
+	if i >= 0 {
+		return false
+	}
+	s.buckets[hash] = append(s.buckets[hash], element)
+	s.size++
+	return true
+}
+
+func (s *CustomHashSet[E]) Remove(element E) bool {
+	hash, i := s.find(element)
+	if i < 0 {
+		return false
+	}
+	if bucket := slices.Delete(s.buckets[hash], i, i+1); len(bucket) > 0 {
+		s.buckets[hash] = bucket
+	} else {
+		delete(s.buckets, hash)
+	}
+	s.size--
+	return true
+}
+
+func (s *CustomHashSet[E]) Contains(element E) bool {
+	_, i := s.find(element)
+	return i >= 0
+}
+
+func (s *CustomHashSet[E]) Size() int {
+	return s.size
+}
+
+func (s *CustomHashSet[E]) IsEmpty() bool {
+	return s.size == 0
+}
+
+func (s *CustomHashSet[E]) Clear() {
+	s.buckets = make(map[uint64][]E)
+	s.size = 0
+}
+
+func (s *CustomHashSet[E]) ToSlice() []E {
+	result := make([]E, 0, s.size)
+	for _, bucket := range s.buckets {
+		result = append(result, bucket...)
+	}
+	return result
+}
+
+func (s *CustomHashSet[E]) All() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		for _, bucket := range s.buckets {
+			for _, element := range bucket {
+				if !yield(element) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (s *CustomHashSet[E]) Union(other collection.Set[E]) collection.Set[E] {
+	result := s.empty()
+	for element := range s.All() {
+		result.Add(element)
+	}
+	for element := range other.All() {
+		result.Add(element)
+	}
+	return result
+}
+
+func (s *CustomHashSet[E]) Intersection(other collection.Set[E]) collection.Set[E] {
+	result := s.empty()
+	for element := range s.All() {
+		if other.Contains(element) {
+			result.Add(element)
+		}
+	}
+	return result
+}
+
+func (s *CustomHashSet[E]) Difference(other collection.Set[E]) collection.Set[E] {
+	result := s.empty()
+	for element := range s.All() {
+		if !other.Contains(element) {
+			result.Add(element)
+		}
+	}
+	return result
+}
+
+// SymmetricDifference returns a new set with the elements found in exactly
+// one of s and other
+func (s *CustomHashSet[E]) SymmetricDifference(other collection.Set[E]) collection.Set[E] {
+	return symmetricDifference(s.empty(), s, other)
+}
+
+func (s *CustomHashSet[E]) IsSubsetOf(other collection.Set[E]) bool {
+	return isSubset(s, other)
+}
+
+func (s *CustomHashSet[E]) IsSupersetOf(other collection.Set[E]) bool {
+	return isSubset(other, s)
+}
+
+func (s *CustomHashSet[E]) IsDisjoint(other collection.Set[E]) bool {
+	return isDisjoint(s, other)
+}
+
+// Equals reports whether s and other hold the same elements
+func (s *CustomHashSet[E]) Equals(other collection.Set[E]) bool {
+	return equal(s, other)
+}
+
+func (s *CustomHashSet[E]) Stream() stream.Stream[E] {
+	return streamOf(s)
+}
+
+func (s *CustomHashSet[E]) Iterator() iterator.Iterator[E] {
+	return iteratorOf(s)
+}
+
+func (s *CustomHashSet[E]) ForEach(action func(E)) {
+	for element := range s.All() {
+		action(element)
+	}
+}
@@ -0,0 +1,88 @@
+package set
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestBytesHashSet(t *testing.T) {
+	set := NewBytesHashSet()
+
+	if !set.Add([]byte("a")) || set.Add([]byte("a")) {
+		t.Error("Expected byte slices with the same content to be one element")
+	}
+	set.Add([]byte("b"))
+	if !set.Contains([]byte("b")) || set.Size() != 2 {
+		t.Errorf("Unexpected contents %q", set.ToSlice())
+	}
+	if !set.Remove([]byte("a")) || set.Remove([]byte("a")) {
+		t.Error("Expected Remove to match by content once")
+	}
+	if set.Size() != 1 {
+		t.Errorf("Expected size 1, got %d", set.Size())
+	}
+}
+
+func TestCaseInsensitiveHashSet(t *testing.T) {
+	set := NewCaseInsensitiveHashSet()
+	set.Add("Go")
+	set.Add("GO")
+	set.Add("rust")
+
+	if set.Size() != 2 || !set.Contains("go") {
+		t.Errorf("Expected case-insensitive membership, got %v", set.ToSlice())
+	}
+
+	plain := NewHashSet[string]()
+	plain.Add("rust")
+	plain.Add("zig")
+
+	union := set.Union(plain)
+	if union.Size() != 3 {
+		t.Errorf("Expected union of 3 elements, got %v", union.ToSlice())
+	}
+	if got := set.Intersection(plain).ToSlice(); !slices.Equal(got, []string{"rust"}) {
+		t.Errorf("Expected intersection [rust], got %v", got)
+	}
+	if got := set.Difference(plain).ToSlice(); !slices.Equal(got, []string{"Go"}) {
+		t.Errorf("Expected difference [Go], got %v", got)
+	}
+	if got := set.SymmetricDifference(plain).Size(); got != 2 {
+		t.Errorf("Expected symmetric difference of 2 elements, got %d", got)
+	}
+	if set.IsDisjoint(plain) || set.IsSubsetOf(plain) {
+		t.Error("Expected overlapping sets that aren't subsets")
+	}
+}
+
+func TestCustomHashSetCollisions(t *testing.T) {
+	set := NewCustomHashSet(func([]int) uint64 { return 0 }, slices.Equal[[]int])
+	for i := range 5 {
+		set.Add([]int{i})
+	}
+	set.Remove([]int{2})
+
+	sum := 0
+	set.ForEach(func(e []int) { sum += e[0] })
+	if set.Size() != 4 || sum != 8 {
+		t.Errorf("Expected 4 elements summing to 8, got %d summing to %d", set.Size(), sum)
+	}
+	if len(set.Iterator().Collect()) != 4 || len(set.Stream().ToSlice()) != 4 {
+		t.Error("Expected iterator and stream to visit every element")
+	}
+
+	other := NewCustomHashSet(func([]int) uint64 { return 1 }, slices.Equal[[]int])
+	for i := range 5 {
+		if i != 2 {
+			other.Add([]int{i})
+		}
+	}
+	if !set.Equals(other) {
+		t.Error("Expected sets with the same elements to be equal")
+	}
+
+	set.Clear()
+	if !set.IsEmpty() {
+		t.Error("Expected set to be empty after Clear")
+	}
+}
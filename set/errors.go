@@ -0,0 +1,12 @@
+package set
+
+import (
+	"github.com/avila-r/ego"
+)
+
+var errors = ego.ExtendedGoErrorsNamespace.Class("set")
+
+var (
+	ErrNegativeCount = errors.New("count must not be negative")
+	ErrNoComparator  = errors.New("no comparator registered for element type")
+)
@@ -1,5 +1,13 @@
 package set
 
+import (
+	"iter"
+	"maps"
+
+	"github.com/avila-r/ego/iterator"
+	"github.com/avila-r/ego/stream"
+)
+
 type HashSet[E comparable] struct {
 	data map[E]struct{}
 }
@@ -51,6 +59,10 @@ func (s *HashSet[E]) ToSlice() []E {
 	return result
 }
 
+func (s *HashSet[E]) All() iter.Seq[E] {
+	return maps.Keys(s.data)
+}
+
 func (s *HashSet[E]) Union(other Settable[E]) Settable[E] {
 	result := NewHashSet[E]()
 	for k := range s.data {
@@ -81,3 +93,40 @@ func (s *HashSet[E]) Difference(other Settable[E]) Settable[E] {
 	}
 	return result
 }
+
+// SymmetricDifference returns a new set with the elements found in exactly
+// one of s and other
+func (s *HashSet[E]) SymmetricDifference(other Settable[E]) Settable[E] {
+	return symmetricDifference(NewHashSet[E](), s, other)
+}
+
+func (s *HashSet[E]) IsSubsetOf(other Settable[E]) bool {
+	return isSubset(s, other)
+}
+
+func (s *HashSet[E]) IsSupersetOf(other Settable[E]) bool {
+	return isSubset(other, s)
+}
+
+func (s *HashSet[E]) IsDisjoint(other Settable[E]) bool {
+	return isDisjoint(s, other)
+}
+
+// Equals reports whether s and other hold the same elements
+func (s *HashSet[E]) Equals(other Settable[E]) bool {
+	return equal(s, other)
+}
+
+func (s *HashSet[E]) Stream() stream.Stream[E] {
+	return streamOf(s)
+}
+
+func (s *HashSet[E]) Iterator() iterator.Iterator[E] {
+	return iteratorOf(s)
+}
+
+func (s *HashSet[E]) ForEach(action func(E)) {
+	for element := range s.All() {
+		action(element)
+	}
+}
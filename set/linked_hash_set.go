@@ -1,5 +1,12 @@
 package set
 
+import (
+	"iter"
+
+	"github.com/avila-r/ego/iterator"
+	"github.com/avila-r/ego/stream"
+)
+
 type LinkedHashSet[E comparable] struct {
 	data  map[E]*node[E]
 	head  *node[E]
@@ -90,6 +97,16 @@ func (s *LinkedHashSet[E]) ToSlice() []E {
 	return result
 }
 
+func (s *LinkedHashSet[E]) All() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		for n := s.head; n != nil; n = n.next {
+			if !yield(n.value) {
+				return
+			}
+		}
+	}
+}
+
 func (s *LinkedHashSet[E]) Union(other Settable[E]) Settable[E] {
 	result := NewLinkedHashSet[E]()
 	for n := s.head; n != nil; n = n.next {
@@ -120,3 +137,40 @@ func (s *LinkedHashSet[E]) Difference(other Settable[E]) Settable[E] {
 	}
 	return result
 }
+
+// SymmetricDifference returns a new set with the elements found in exactly
+// one of s and other
+func (s *LinkedHashSet[E]) SymmetricDifference(other Settable[E]) Settable[E] {
+	return symmetricDifference(NewLinkedHashSet[E](), s, other)
+}
+
+func (s *LinkedHashSet[E]) IsSubsetOf(other Settable[E]) bool {
+	return isSubset(s, other)
+}
+
+func (s *LinkedHashSet[E]) IsSupersetOf(other Settable[E]) bool {
+	return isSubset(other, s)
+}
+
+func (s *LinkedHashSet[E]) IsDisjoint(other Settable[E]) bool {
+	return isDisjoint(s, other)
+}
+
+// Equals reports whether s and other hold the same elements
+func (s *LinkedHashSet[E]) Equals(other Settable[E]) bool {
+	return equal(s, other)
+}
+
+func (s *LinkedHashSet[E]) Stream() stream.Stream[E] {
+	return streamOf(s)
+}
+
+func (s *LinkedHashSet[E]) Iterator() iterator.Iterator[E] {
+	return iteratorOf(s)
+}
+
+func (s *LinkedHashSet[E]) ForEach(action func(E)) {
+	for element := range s.All() {
+		action(element)
+	}
+}
@@ -0,0 +1,269 @@
+package set
+
+import (
+	"cmp"
+	"iter"
+
+	"github.com/avila-r/ego/collection"
+	"github.com/avila-r/ego/constraint"
+	"github.com/avila-r/ego/function"
+	"github.com/avila-r/ego/iterator"
+	"github.com/avila-r/ego/maps"
+	"github.com/avila-r/ego/queue"
+)
+
+// Counter reports how many times each of its elements occurs. Every
+// Multiset is a Counter, and AsCounter turns any Settable into one.
+type Counter[E comparable] interface {
+	Count(element E) int
+	DistinctElements() Settable[E]
+}
+
+// AsCounter views s as a Counter in which each element occurs once
+func AsCounter[E comparable](s Settable[E]) Counter[E] {
+	return settableCounter[E]{s}
+}
+
+type settableCounter[E comparable] struct {
+	Settable[E]
+}
+
+func (c settableCounter[E]) Count(element E) int {
+	if c.Contains(element) {
+		return 1
+	}
+	return 0
+}
+
+func (c settableCounter[E]) DistinctElements() Settable[E] {
+	return c.Settable
+}
+
+// Multiset is a set that counts how many times each element was added,
+// also known as a bag
+type Multiset[E comparable] struct {
+	counts    collection.Map[E, int]
+	size      int
+	newCounts func() collection.Map[E, int]
+	newSet    func() Settable[E]
+}
+
+// Bag is another name for Multiset
+type Bag[E comparable] = Multiset[E]
+
+var _ Counter[int] = (*Multiset[int])(nil)
+
+// NewHashMultiset creates an empty multiset with unspecified iteration order
+func NewHashMultiset[E comparable]() *Multiset[E] {
+	return &Multiset[E]{
+		counts: maps.NewHashMap[E, int](),
+		newCounts: func() collection.Map[E, int] {
+			return maps.NewHashMap[E, int]()
+		},
+		newSet: func() Settable[E] {
+			return NewHashSet[E]()
+		},
+	}
+}
+
+// NewTreeMultiset creates an empty multiset whose distinct elements are
+// ordered by comparator
+func NewTreeMultiset[E comparable](comparator function.Comparator[E]) *Multiset[E] {
+	return &Multiset[E]{
+		counts: maps.NewTreeMap[E, int](comparator),
+		newCounts: func() collection.Map[E, int] {
+			return maps.NewTreeMap[E, int](comparator)
+		},
+		newSet: func() Settable[E] {
+			return NewTreeSetWith(comparator)
+		},
+	}
+}
+
+// NewOrderedTreeMultiset creates an empty multiset whose distinct elements
+// follow the natural order of E
+func NewOrderedTreeMultiset[E constraint.Ordered]() *Multiset[E] {
+	return NewTreeMultiset(function.NewComparator(cmp.Compare[E]))
+}
+
+// empty returns an empty multiset of the same variant
+func (m *Multiset[E]) empty() *Multiset[E] {
+	return &Multiset[E]{
+		counts:    m.newCounts(),
+		newCounts: m.newCounts,
+		newSet:    m.newSet,
+	}
+}
+
+// Add adds count occurrences of element and returns the count it had
+// before. It panics with ErrNegativeCount if count is negative.
+func (m *Multiset[E]) Add(element E, count int) int {
+	if count < 0 {
+		ErrNegativeCount.Panic()
+	}
+	previous := m.Count(element)
+	m.SetCount(element, previous+count)
+	return previous
+}
+
+// Remove removes up to count occurrences of element and returns the count
+// it had before. It panics with ErrNegativeCount if count is negative.
+func (m *Multiset[E]) Remove(element E, count int) int {
+	if count < 0 {
+		ErrNegativeCount.Panic()
+	}
+	previous := m.Count(element)
+	m.SetCount(element, max(previous-count, 0))
+	return previous
+}
+
+// SetCount sets the number of occurrences of element, removing it when
+// count is zero, and returns the count it had before
+func (m *Multiset[E]) SetCount(element E, count int) int {
+	if count < 0 {
+		ErrNegativeCount.Panic()
+	}
+	previous := m.Count(element)
+	if count == 0 {
+		m.counts.Delete(element)
+	} else {
+		m.counts.Put(element, count)
+	}
+	m.size += count - previous
+	return previous
+}
+
+// Count returns the number of occurrences of element
+func (m *Multiset[E]) Count(element E) int {
+	return m.counts.GetOrDefault(element, 0)
+}
+
+func (m *Multiset[E]) Contains(element E) bool {
+	return m.counts.ContainsKey(element)
+}
+
+// Size returns the total number of occurrences of all elements
+func (m *Multiset[E]) Size() int {
+	return m.size
+}
+
+func (m *Multiset[E]) IsEmpty() bool {
+	return m.size == 0
+}
+
+func (m *Multiset[E]) Clear() {
+	m.counts.Clear()
+	m.size = 0
+}
+
+// DistinctElements returns a new set holding each element once
+func (m *Multiset[E]) DistinctElements() Settable[E] {
+	distinct := m.newSet()
+	for element := range m.counts.All() {
+		distinct.Add(element)
+	}
+	return distinct
+}
+
+// EntrySet returns each distinct element paired with its count
+func (m *Multiset[E]) EntrySet() collection.Collection[collection.Entry[E, int]] {
+	return collection.Of(m.counts.ToSlice()...)
+}
+
+// TopN returns the n most frequent elements with their counts, most
+// frequent first. Elements with equal counts keep iteration order.
+func (m *Multiset[E]) TopN(n int) []collection.Entry[E, int] {
+	if n <= 0 {
+		return []collection.Entry[E, int]{}
+	}
+
+	// Among equal counts the element seen first ranks higher
+	type ranked struct {
+		entry collection.Entry[E, int]
+		index int
+	}
+	top := queue.NewMaxBounded(n, function.NewComparator(func(a, b ranked) int {
+		if c := cmp.Compare(a.entry.Value, b.entry.Value); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.index, a.index)
+	}))
+
+	index := 0
+	for element, count := range m.counts.All() {
+		top.Offer(ranked{collection.Entry[E, int]{Key: element, Value: count}, index})
+		index++
+	}
+
+	sorted := top.Sorted()
+	result := make([]collection.Entry[E, int], len(sorted))
+	for i, r := range sorted {
+		result[i] = r.entry
+	}
+	return result
+}
+
+// ToSlice returns every occurrence, with repeated elements next to each
+// other
+func (m *Multiset[E]) ToSlice() []E {
+	result := make([]E, 0, m.size)
+	for element, count := range m.counts.All() {
+		for range count {
+			result = append(result, element)
+		}
+	}
+	return result
+}
+
+// All returns a sequence over the distinct elements and their counts
+func (m *Multiset[E]) All() iter.Seq2[E, int] {
+	return m.counts.All()
+}
+
+func (m *Multiset[E]) Iterator() iterator.Iterator[collection.Entry[E, int]] {
+	return m.counts.Iterator()
+}
+
+// Union returns a multiset where each element occurs as many times as in
+// whichever of m and other has more of it
+func (m *Multiset[E]) Union(other Counter[E]) *Multiset[E] {
+	result := m.clone()
+	for element := range other.DistinctElements().All() {
+		if count := other.Count(element); count > result.Count(element) {
+			result.SetCount(element, count)
+		}
+	}
+	return result
+}
+
+// Intersection returns a multiset where each element occurs as many times
+// as in whichever of m and other has fewer of it
+func (m *Multiset[E]) Intersection(other Counter[E]) *Multiset[E] {
+	result := m.empty()
+	for element, count := range m.counts.All() {
+		if common := min(count, other.Count(element)); common > 0 {
+			result.SetCount(element, common)
+		}
+	}
+	return result
+}
+
+// Difference returns a multiset with other's occurrences taken out of m's,
+// never going below zero
+func (m *Multiset[E]) Difference(other Counter[E]) *Multiset[E] {
+	result := m.empty()
+	for element, count := range m.counts.All() {
+		if left := count - other.Count(element); left > 0 {
+			result.SetCount(element, left)
+		}
+	}
+	return result
+}
+
+func (m *Multiset[E]) clone() *Multiset[E] {
+	result := m.empty()
+	for element, count := range m.counts.All() {
+		result.SetCount(element, count)
+	}
+	return result
+}
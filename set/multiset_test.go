@@ -0,0 +1,186 @@
+package set
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/avila-r/ego/collection"
+)
+
+func TestMultisetCounting(t *testing.T) {
+	bags := map[string]*Multiset[string]{
+		"hash": NewHashMultiset[string](),
+		"tree": NewOrderedTreeMultiset[string](),
+	}
+
+	for name, bag := range bags {
+		t.Run(name, func(t *testing.T) {
+			if previous := bag.Add("a", 3); previous != 0 {
+				t.Errorf("Expected previous count 0, got %d", previous)
+			}
+			bag.Add("b", 1)
+			if previous := bag.Add("a", 2); previous != 3 {
+				t.Errorf("Expected previous count 3, got %d", previous)
+			}
+			bag.Add("c", 0)
+
+			if bag.Count("a") != 5 || bag.Count("b") != 1 || bag.Count("c") != 0 {
+				t.Errorf("Unexpected counts a=%d b=%d c=%d", bag.Count("a"), bag.Count("b"), bag.Count("c"))
+			}
+			if bag.Contains("c") {
+				t.Error("Expected adding zero occurrences not to add the element")
+			}
+			if bag.Size() != 6 {
+				t.Errorf("Expected size 6, got %d", bag.Size())
+			}
+
+			if previous := bag.Remove("a", 2); previous != 5 {
+				t.Errorf("Expected previous count 5, got %d", previous)
+			}
+			bag.Remove("b", 10)
+			if bag.Count("a") != 3 || bag.Contains("b") {
+				t.Errorf("Unexpected counts after remove a=%d b=%d", bag.Count("a"), bag.Count("b"))
+			}
+			if bag.Size() != 3 {
+				t.Errorf("Expected size 3, got %d", bag.Size())
+			}
+
+			bag.SetCount("z", 2)
+			if bag.Size() != 5 || bag.DistinctElements().Size() != 2 {
+				t.Errorf("Expected 5 occurrences of 2 elements, got %d of %d", bag.Size(), bag.DistinctElements().Size())
+			}
+
+			bag.Clear()
+			if !bag.IsEmpty() || bag.Count("a") != 0 {
+				t.Error("Expected bag to be empty after Clear")
+			}
+		})
+	}
+}
+
+func TestMultisetNegativeCount(t *testing.T) {
+	operations := map[string]func(*Multiset[int]){
+		"Add":      func(m *Multiset[int]) { m.Add(1, -1) },
+		"Remove":   func(m *Multiset[int]) { m.Remove(1, -1) },
+		"SetCount": func(m *Multiset[int]) { m.SetCount(1, -1) },
+	}
+
+	for name, operation := range operations {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("Expected a panic for a negative count")
+				}
+			}()
+			operation(NewHashMultiset[int]())
+		})
+	}
+}
+
+func TestTreeMultisetOrder(t *testing.T) {
+	bag := NewOrderedTreeMultiset[int]()
+	for _, n := range []int{3, 1, 3, 2, 1, 3} {
+		bag.Add(n, 1)
+	}
+
+	if got := bag.ToSlice(); !slices.Equal(got, []int{1, 1, 2, 3, 3, 3}) {
+		t.Errorf("Expected sorted occurrences, got %v", got)
+	}
+	if got := bag.DistinctElements().ToSlice(); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("Expected sorted distinct elements, got %v", got)
+	}
+
+	expected := []collection.Entry[int, int]{{Key: 1, Value: 2}, {Key: 2, Value: 1}, {Key: 3, Value: 3}}
+	if got := bag.EntrySet().Elements(); !slices.Equal(got, expected) {
+		t.Errorf("Expected entries %v, got %v", expected, got)
+	}
+	if got := bag.Iterator().Collect(); !slices.Equal(got, expected) {
+		t.Errorf("Expected iterator entries %v, got %v", expected, got)
+	}
+}
+
+func TestMultisetTopN(t *testing.T) {
+	bag := NewOrderedTreeMultiset[string]()
+	for _, word := range []string{"to", "be", "or", "not", "to", "be", "that", "is", "to"} {
+		bag.Add(word, 1)
+	}
+
+	tests := []struct {
+		n        int
+		expected []collection.Entry[string, int]
+	}{
+		{0, []collection.Entry[string, int]{}},
+		{1, []collection.Entry[string, int]{{Key: "to", Value: 3}}},
+		// "is" precedes the other single occurrences in iteration order
+		{3, []collection.Entry[string, int]{{Key: "to", Value: 3}, {Key: "be", Value: 2}, {Key: "is", Value: 1}}},
+	}
+
+	for _, tt := range tests {
+		if got := bag.TopN(tt.n); !slices.Equal(got, tt.expected) {
+			t.Errorf("TopN(%d): expected %v, got %v", tt.n, tt.expected, got)
+		}
+	}
+	if got := bag.TopN(100); len(got) != 6 {
+		t.Errorf("Expected TopN beyond size to return all 6 elements, got %d", len(got))
+	}
+}
+
+func TestMultisetSetOperations(t *testing.T) {
+	a := NewOrderedTreeMultiset[string]()
+	a.Add("x", 3)
+	a.Add("y", 1)
+	b := NewHashMultiset[string]()
+	b.Add("x", 1)
+	b.Add("y", 2)
+	b.Add("z", 1)
+
+	tests := []struct {
+		name     string
+		result   *Multiset[string]
+		expected []string
+	}{
+		{"union", a.Union(b), []string{"x", "x", "x", "y", "y", "z"}},
+		{"intersection", a.Intersection(b), []string{"x", "y"}},
+		{"difference", a.Difference(b), []string{"x", "x"}},
+		{"reverse difference", b.Difference(a), []string{"y", "z"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.result.ToSlice()
+			slices.Sort(got)
+			if !slices.Equal(got, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+
+	if a.Count("x") != 3 || a.Size() != 4 {
+		t.Error("Expected set operations to leave their operands unchanged")
+	}
+}
+
+func TestMultisetWithSettable(t *testing.T) {
+	bag := NewHashMultiset[int]()
+	bag.Add(1, 2)
+	bag.Add(2, 1)
+
+	plain := NewHashSet[int]()
+	plain.Add(1)
+	plain.Add(3)
+
+	union := bag.Union(AsCounter[int](plain))
+	if union.Count(1) != 2 || union.Count(2) != 1 || union.Count(3) != 1 {
+		t.Errorf("Unexpected union counts: %v", union.EntrySet().Elements())
+	}
+
+	difference := bag.Difference(AsCounter[int](plain))
+	if difference.Count(1) != 1 || difference.Count(2) != 1 || difference.Size() != 2 {
+		t.Errorf("Unexpected difference counts: %v", difference.EntrySet().Elements())
+	}
+
+	distinct := bag.DistinctElements().Intersection(plain)
+	if got := distinct.ToSlice(); !slices.Equal(got, []int{1}) {
+		t.Errorf("Expected distinct elements to intersect with a set, got %v", got)
+	}
+}
@@ -1,5 +1,13 @@
 package set
 
+import (
+	"iter"
+	"slices"
+
+	"github.com/avila-r/ego/iterator"
+	"github.com/avila-r/ego/stream"
+)
+
 type Set[E comparable] struct {
 	elements []E
 }
@@ -106,16 +114,16 @@ func (s *Set[E]) retainIfPresent(elements []E, element E, keep bool) bool {
 	return keep
 }
 
-func (s *Set[E]) Equals(other E) bool {
-	return s.Size() == 1 && s.Contains(other)
-}
-
 func (s *Set[E]) ToSlice() []E {
 	result := make([]E, len(s.elements))
 	copy(result, s.elements)
 	return result
 }
 
+func (s *Set[E]) All() iter.Seq[E] {
+	return slices.Values(s.elements)
+}
+
 func (s *Set[E]) Union(other Settable[E]) Settable[E] {
 	result := NewSet([]E{})
 	for _, element := range s.elements {
@@ -146,3 +154,40 @@ func (s *Set[E]) Difference(other Settable[E]) Settable[E] {
 	}
 	return result
 }
+
+// SymmetricDifference returns a new set with the elements found in exactly
+// one of s and other
+func (s *Set[E]) SymmetricDifference(other Settable[E]) Settable[E] {
+	return symmetricDifference(NewSet([]E{}), s, other)
+}
+
+func (s *Set[E]) IsSubsetOf(other Settable[E]) bool {
+	return isSubset(s, other)
+}
+
+func (s *Set[E]) IsSupersetOf(other Settable[E]) bool {
+	return isSubset(other, s)
+}
+
+func (s *Set[E]) IsDisjoint(other Settable[E]) bool {
+	return isDisjoint(s, other)
+}
+
+// Equals reports whether s and other hold the same elements
+func (s *Set[E]) Equals(other Settable[E]) bool {
+	return equal(s, other)
+}
+
+func (s *Set[E]) Stream() stream.Stream[E] {
+	return streamOf(s)
+}
+
+func (s *Set[E]) Iterator() iterator.Iterator[E] {
+	return iteratorOf(s)
+}
+
+func (s *Set[E]) ForEach(action func(E)) {
+	for element := range s.All() {
+		action(element)
+	}
+}
@@ -1,6 +1,11 @@
 package set
 
-import "testing"
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+)
 
 func TestHashSetBasicOperations(t *testing.T) {
 	set := NewHashSet[int]()
@@ -281,6 +286,35 @@ func BenchmarkTreeSetAdd(b *testing.B) {
 	}
 }
 
+// BenchmarkTreeSetAddN inserts n elements per iteration. With a balanced tree
+// the cost per element grows logarithmically with n rather than linearly.
+func BenchmarkTreeSetAddN(b *testing.B) {
+	for _, n := range []int{1_000, 10_000, 100_000} {
+		elements := rand.New(rand.NewSource(1)).Perm(n)
+
+		b.Run(fmt.Sprintf("n=%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				set := NewOrderedTreeSet[int]()
+				for _, element := range elements {
+					set.Add(element)
+				}
+			}
+			b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(b.N*n), "ns/element")
+		})
+	}
+}
+
+func BenchmarkTreeSetRemove(b *testing.B) {
+	set := NewOrderedTreeSet[int]()
+	for i := 0; i < b.N; i++ {
+		set.Add(i)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		set.Remove(i)
+	}
+}
+
 func BenchmarkLinkedHashSetAdd(b *testing.B) {
 	set := NewLinkedHashSet[int]()
 	b.ResetTimer()
@@ -364,3 +398,146 @@ func TestSingleElement(t *testing.T) {
 		t.Error("Expected to be empty after removing only element")
 	}
 }
+
+func TestAll(t *testing.T) {
+	hashSet := NewHashSet[int]()
+	treeSet := NewTreeSet[int](func(a, b int) bool { return a < b })
+	linkedSet := NewLinkedHashSet[int]()
+	plainSet := NewSet([]int{})
+
+	sets := []Settable[int]{hashSet, treeSet, linkedSet, plainSet}
+	names := []string{"HashSet", "TreeSet", "LinkedHashSet", "Set"}
+
+	for i, set := range sets {
+		set.Add(3)
+		set.Add(1)
+		set.Add(2)
+
+		sum := 0
+		count := 0
+		for v := range set.All() {
+			sum += v
+			count++
+		}
+		if count != 3 || sum != 6 {
+			t.Errorf("%s: Expected All to yield 3 elements summing to 6, got %d summing to %d", names[i], count, sum)
+		}
+	}
+
+	var ordered []int
+	for v := range treeSet.All() {
+		ordered = append(ordered, v)
+	}
+	if len(ordered) != 3 || ordered[0] != 1 || ordered[1] != 2 || ordered[2] != 3 {
+		t.Errorf("TreeSet: Expected All in sorted order, got %v", ordered)
+	}
+
+	ordered = nil
+	for v := range linkedSet.All() {
+		if v == 1 {
+			break
+		}
+		ordered = append(ordered, v)
+	}
+	if len(ordered) != 1 || ordered[0] != 3 {
+		t.Errorf("LinkedHashSet: Expected early break after first element, got %v", ordered)
+	}
+}
+
+func TestTreeSetNavigation(t *testing.T) {
+	set := NewOrderedTreeSet[int]()
+	for _, v := range []int{10, 20, 30, 40} {
+		set.Add(v)
+	}
+
+	if first, ok := set.First(); !ok || first != 10 {
+		t.Errorf("Expected first 10, got %d", first)
+	}
+	if last, ok := set.Last(); !ok || last != 40 {
+		t.Errorf("Expected last 40, got %d", last)
+	}
+
+	cases := []struct {
+		name     string
+		find     func(int) (int, bool)
+		element  int
+		expected int
+		ok       bool
+	}{
+		{"Floor", set.Floor, 25, 20, true},
+		{"Floor exact", set.Floor, 20, 20, true},
+		{"Floor below", set.Floor, 5, 0, false},
+		{"Ceiling", set.Ceiling, 25, 30, true},
+		{"Ceiling above", set.Ceiling, 45, 0, false},
+		{"Lower exact", set.Lower, 20, 10, true},
+		{"Higher exact", set.Higher, 20, 30, true},
+		{"Higher above", set.Higher, 40, 0, false},
+	}
+	for _, c := range cases {
+		if got, ok := c.find(c.element); got != c.expected || ok != c.ok {
+			t.Errorf("%s(%d): expected (%d, %v), got (%d, %v)", c.name, c.element, c.expected, c.ok, got, ok)
+		}
+	}
+}
+
+func TestTreeSetPoll(t *testing.T) {
+	set := NewOrderedTreeSet[string]()
+	set.Add("b")
+	set.Add("a")
+	set.Add("c")
+
+	if first, _ := set.PollFirst(); first != "a" {
+		t.Errorf("Expected PollFirst to return a, got %s", first)
+	}
+	if last, _ := set.PollLast(); last != "c" {
+		t.Errorf("Expected PollLast to return c, got %s", last)
+	}
+	if !slices.Equal(set.ToSlice(), []string{"b"}) {
+		t.Errorf("Expected [b] after polling, got %v", set.ToSlice())
+	}
+
+	set.Clear()
+	if _, ok := set.PollFirst(); ok {
+		t.Error("Expected PollFirst on empty set to report false")
+	}
+}
+
+func TestTreeSetRanges(t *testing.T) {
+	set := NewTreeSet[int](func(a, b int) bool { return a < b })
+	for i := 1; i <= 5; i++ {
+		set.Add(i)
+	}
+
+	if got := set.HeadSet(3).ToSlice(); !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("Expected HeadSet(3) [1 2], got %v", got)
+	}
+	if got := set.TailSet(3).ToSlice(); !slices.Equal(got, []int{3, 4, 5}) {
+		t.Errorf("Expected TailSet(3) [3 4 5], got %v", got)
+	}
+
+	sub := set.SubSet(2, 4)
+	if got := sub.ToSlice(); !slices.Equal(got, []int{2, 3}) {
+		t.Errorf("Expected SubSet(2, 4) [2 3], got %v", got)
+	}
+	sub.Add(9)
+	if set.Contains(9) {
+		t.Error("Expected SubSet to be independent of the original set")
+	}
+}
+
+func TestTreeSetDescending(t *testing.T) {
+	set := NewTreeSet[int](func(a, b int) bool { return a > b })
+	for _, v := range []int{2, 3, 1} {
+		set.Add(v)
+	}
+
+	if got := set.ToSlice(); !slices.Equal(got, []int{3, 2, 1}) {
+		t.Errorf("Expected custom order [3 2 1], got %v", got)
+	}
+	if got := set.DescendingIterator().Collect(); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("Expected descending iteration [1 2 3], got %v", got)
+	}
+	if got := slices.Collect(set.Backward()); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("Expected Backward [1 2 3], got %v", got)
+	}
+}
@@ -1,14 +1,69 @@
 package set
 
-type Settable[E comparable] interface {
-	Add(element E) bool
-	Remove(element E) bool
-	Contains(element E) bool
-	Size() int
-	IsEmpty() bool
-	Clear()
-	ToSlice() []E
-	Union(other Settable[E]) Settable[E]
-	Intersection(other Settable[E]) Settable[E]
-	Difference(other Settable[E]) Settable[E]
+import (
+	"github.com/avila-r/ego/collection"
+	"github.com/avila-r/ego/iterator"
+	"github.com/avila-r/ego/stream"
+)
+
+// Settable is the interface every set in this package implements
+type Settable[E comparable] = collection.Set[E]
+
+var (
+	_ Settable[int] = (*Set[int])(nil)
+	_ Settable[int] = (*HashSet[int])(nil)
+	_ Settable[int] = (*LinkedHashSet[int])(nil)
+	_ Settable[int] = (*TreeSet[int])(nil)
+)
+
+// symmetricDifference adds to result the elements found in exactly one of
+// s and other
+func symmetricDifference[E any](result, s, other collection.Set[E]) collection.Set[E] {
+	for element := range s.All() {
+		if !other.Contains(element) {
+			result.Add(element)
+		}
+	}
+	for element := range other.All() {
+		if !s.Contains(element) {
+			result.Add(element)
+		}
+	}
+	return result
+}
+
+func isSubset[E any](s, other collection.Set[E]) bool {
+	if s.Size() > other.Size() {
+		return false
+	}
+	for element := range s.All() {
+		if !other.Contains(element) {
+			return false
+		}
+	}
+	return true
+}
+
+func isDisjoint[E any](s, other collection.Set[E]) bool {
+	if s.Size() > other.Size() {
+		s, other = other, s
+	}
+	for element := range s.All() {
+		if other.Contains(element) {
+			return false
+		}
+	}
+	return true
+}
+
+func equal[E any](s, other collection.Set[E]) bool {
+	return other != nil && s.Size() == other.Size() && isSubset(s, other)
+}
+
+func streamOf[E any](s collection.Set[E]) stream.Stream[E] {
+	return stream.FromSeq(s.All())
+}
+
+func iteratorOf[E any](s collection.Set[E]) iterator.Iterator[E] {
+	return iterator.FromSeq(s.All())
 }
@@ -1,88 +1,184 @@
 package set
 
+import (
+	"cmp"
+	"iter"
+
+	"github.com/avila-r/ego/constraint"
+	"github.com/avila-r/ego/function"
+	"github.com/avila-r/ego/iterator"
+	"github.com/avila-r/ego/maps"
+	"github.com/avila-r/ego/stream"
+)
+
+// TreeSet is a sorted set backed by a red-black tree. Add, Remove and
+// Contains are O(log n) and iteration follows the set's order.
 type TreeSet[E comparable] struct {
-	data []E
-	less func(a, b E) bool
+	tree       *maps.TreeMap[E, struct{}]
+	comparator function.Comparator[E]
 }
 
-func NewTreeSet[E comparable](less func(a, b E) bool) *TreeSet[E] {
-	return &TreeSet[E]{
-		data: make([]E, 0),
-		less: less,
-	}
-}
+var _ Settable[int] = (*TreeSet[int])(nil)
 
-func (s *TreeSet[E]) binarySearch(element E) (int, bool) {
-	left, right := 0, len(s.data)
-	for left < right {
-		mid := (left + right) / 2
+// NewTreeSet creates an empty set ordered by less
+func NewTreeSet[E comparable](less func(a, b E) bool) *TreeSet[E] {
+	return NewTreeSetWith(function.NewComparator(func(a, b E) int {
 		switch {
-		case s.less(s.data[mid], element):
-			left = mid + 1
-		case s.less(element, s.data[mid]):
-			right = mid
+		case less(a, b):
+			return -1
+		case less(b, a):
+			return 1
 		default:
-			return mid, true
+			return 0
 		}
+	}))
+}
+
+// NewTreeSetWith creates an empty set ordered by comparator
+func NewTreeSetWith[E comparable](comparator function.Comparator[E]) *TreeSet[E] {
+	return &TreeSet[E]{
+		tree:       maps.NewTreeMap[E, struct{}](comparator),
+		comparator: comparator,
 	}
-	return left, false
+}
+
+// NewOrderedTreeSet creates an empty set ordered by the natural order of E
+func NewOrderedTreeSet[E constraint.Ordered]() *TreeSet[E] {
+	return NewTreeSetWith(function.NewComparator(cmp.Compare[E]))
 }
 
 func (s *TreeSet[E]) Add(element E) bool {
-	idx, found := s.binarySearch(element)
-	if found {
-		return false
-	}
-	s.data = append(s.data[:idx], append([]E{element}, s.data[idx:]...)...)
-	return true
+	return s.tree.PutIfAbsent(element, struct{}{})
 }
 
 func (s *TreeSet[E]) Remove(element E) bool {
-	idx, found := s.binarySearch(element)
-	if !found {
+	if !s.tree.ContainsKey(element) {
 		return false
 	}
-	s.data = append(s.data[:idx], s.data[idx+1:]...)
+	s.tree.Delete(element)
 	return true
 }
 
 func (s *TreeSet[E]) Contains(element E) bool {
-	_, found := s.binarySearch(element)
-	return found
+	return s.tree.ContainsKey(element)
 }
 
 func (s *TreeSet[E]) Size() int {
-	return len(s.data)
+	return s.tree.Len()
 }
 
 func (s *TreeSet[E]) IsEmpty() bool {
-	return len(s.data) == 0
+	return s.tree.IsEmpty()
 }
 
 func (s *TreeSet[E]) Clear() {
-	s.data = make([]E, 0)
+	s.tree.Clear()
 }
 
 func (s *TreeSet[E]) ToSlice() []E {
-	result := make([]E, len(s.data))
-	copy(result, s.data)
-	return result
+	return s.tree.KeySlice()
+}
+
+func (s *TreeSet[E]) All() iter.Seq[E] {
+	return keys(s.tree.All())
+}
+
+// Backward returns a sequence over the elements in descending order
+func (s *TreeSet[E]) Backward() iter.Seq[E] {
+	return keys(s.tree.Backward())
+}
+
+func (s *TreeSet[E]) Iterator() iterator.Iterator[E] {
+	return iterator.Iterate(s.First, s.Higher)
+}
+
+// DescendingIterator returns an iterator over the elements in descending
+// order
+func (s *TreeSet[E]) DescendingIterator() iterator.Iterator[E] {
+	return iterator.Iterate(s.Last, s.Lower)
+}
+
+// First returns the smallest element, if the set isn't empty
+func (s *TreeSet[E]) First() (E, bool) {
+	return s.tree.FirstKey()
+}
+
+// Last returns the largest element, if the set isn't empty
+func (s *TreeSet[E]) Last() (E, bool) {
+	return s.tree.LastKey()
+}
+
+// Floor returns the largest element less than or equal to element
+func (s *TreeSet[E]) Floor(element E) (E, bool) {
+	entry, ok := s.tree.Floor(element)
+	return entry.Key, ok
+}
+
+// Ceiling returns the smallest element greater than or equal to element
+func (s *TreeSet[E]) Ceiling(element E) (E, bool) {
+	entry, ok := s.tree.Ceiling(element)
+	return entry.Key, ok
+}
+
+// Lower returns the largest element strictly less than element
+func (s *TreeSet[E]) Lower(element E) (E, bool) {
+	entry, ok := s.tree.Lower(element)
+	return entry.Key, ok
+}
+
+// Higher returns the smallest element strictly greater than element
+func (s *TreeSet[E]) Higher(element E) (E, bool) {
+	entry, ok := s.tree.Higher(element)
+	return entry.Key, ok
+}
+
+// PollFirst removes and returns the smallest element
+func (s *TreeSet[E]) PollFirst() (E, bool) {
+	first, ok := s.tree.FirstKey()
+	if ok {
+		s.tree.Delete(first)
+	}
+	return first, ok
+}
+
+// PollLast removes and returns the largest element
+func (s *TreeSet[E]) PollLast() (E, bool) {
+	last, ok := s.tree.LastKey()
+	if ok {
+		s.tree.Delete(last)
+	}
+	return last, ok
+}
+
+// HeadSet returns a new set with the elements strictly less than to
+func (s *TreeSet[E]) HeadSet(to E) *TreeSet[E] {
+	return &TreeSet[E]{tree: s.tree.HeadMap(to), comparator: s.comparator}
+}
+
+// TailSet returns a new set with the elements greater than or equal to from
+func (s *TreeSet[E]) TailSet(from E) *TreeSet[E] {
+	return &TreeSet[E]{tree: s.tree.TailMap(from), comparator: s.comparator}
+}
+
+// SubSet returns a new set with the elements in [from, to)
+func (s *TreeSet[E]) SubSet(from, to E) *TreeSet[E] {
+	return &TreeSet[E]{tree: s.tree.SubMap(from, to), comparator: s.comparator}
 }
 
 func (s *TreeSet[E]) Union(other Settable[E]) Settable[E] {
-	result := NewTreeSet[E](s.less)
-	for _, v := range s.data {
+	result := NewTreeSetWith(s.comparator)
+	for v := range s.All() {
 		result.Add(v)
 	}
-	for _, v := range other.ToSlice() {
+	for v := range other.All() {
 		result.Add(v)
 	}
 	return result
 }
 
 func (s *TreeSet[E]) Intersection(other Settable[E]) Settable[E] {
-	result := NewTreeSet[E](s.less)
-	for _, v := range s.data {
+	result := NewTreeSetWith(s.comparator)
+	for v := range s.All() {
 		if other.Contains(v) {
 			result.Add(v)
 		}
@@ -91,11 +187,55 @@ func (s *TreeSet[E]) Intersection(other Settable[E]) Settable[E] {
 }
 
 func (s *TreeSet[E]) Difference(other Settable[E]) Settable[E] {
-	result := NewTreeSet[E](s.less)
-	for _, v := range s.data {
+	result := NewTreeSetWith(s.comparator)
+	for v := range s.All() {
 		if !other.Contains(v) {
 			result.Add(v)
 		}
 	}
 	return result
 }
+
+// SymmetricDifference returns a new set with the elements found in exactly
+// one of s and other
+func (s *TreeSet[E]) SymmetricDifference(other Settable[E]) Settable[E] {
+	return symmetricDifference(NewTreeSetWith(s.comparator), s, other)
+}
+
+func (s *TreeSet[E]) IsSubsetOf(other Settable[E]) bool {
+	return isSubset(s, other)
+}
+
+func (s *TreeSet[E]) IsSupersetOf(other Settable[E]) bool {
+	return isSubset(other, s)
+}
+
+func (s *TreeSet[E]) IsDisjoint(other Settable[E]) bool {
+	return isDisjoint(s, other)
+}
+
+// Equals reports whether s and other hold the same elements
+func (s *TreeSet[E]) Equals(other Settable[E]) bool {
+	return equal(s, other)
+}
+
+func (s *TreeSet[E]) Stream() stream.Stream[E] {
+	return streamOf(s)
+}
+
+func (s *TreeSet[E]) ForEach(action func(E)) {
+	for element := range s.All() {
+		action(element)
+	}
+}
+
+// keys adapts a key/value sequence into a sequence of its keys
+func keys[K, V any](seq iter.Seq2[K, V]) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range seq {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
@@ -74,7 +74,7 @@ func IsNil[T any](t []T) bool {
 	return t == nil
 }
 
-func Stream[T comparable](t []T) stream.Stream[T] {
+func Stream[T any](t []T) stream.Stream[T] {
 	return stream.Of(t...)
 }
 
@@ -47,13 +47,14 @@ func NewCollector[T, A, R any](
 	}
 }
 
-func (d *DefaultCollector[T, R, R]) Of(
+// CollectorOf creates a collector whose accumulation container is also its
+// result, so no finishing step is needed
+func CollectorOf[T, R any](
 	supplier function.Supplier[R],
 	accumulator function.BiConsumer[R, T],
 	combiner function.BinaryOperator[R],
-	finisher function.Function[R, R],
 ) Collector[T, R, R] {
-	return NewCollector[T, R, R](supplier, accumulator, combiner, finisher)
+	return NewCollector(supplier, accumulator, combiner, function.IdentityFunction[R]())
 }
 
 // TODO: Implement Characteristics enum and related methods
@@ -0,0 +1,45 @@
+package stream
+
+import "github.com/avila-r/ego/function"
+
+// Distinct drops elements that are equal to an earlier element
+func Distinct[T comparable](s Stream[T]) Stream[T] {
+	return DistinctBy(s, function.IdentityFunction[T]())
+}
+
+// DistinctBy drops elements whose key is equal to the key of an earlier
+// element. It works for element types that are not comparable themselves.
+func DistinctBy[T any, K comparable](s Stream[T], keyExtractor function.Function[T, K]) Stream[T] {
+	return s.pipe(func(yield func(T) bool) {
+		seen := make(map[K]struct{})
+		for element := range s.All() {
+			key := keyExtractor.Apply(element)
+			if _, found := seen[key]; found {
+				continue
+			}
+			seen[key] = struct{}{}
+			if !yield(element) {
+				return
+			}
+		}
+	})
+}
+
+// Contains reports whether any element is equal to value.
+// It stops pulling from upstream at the first match.
+func Contains[T comparable](s Stream[T], value T) bool {
+	return s.AnyMatch(function.IsEqual(value))
+}
+
+// IndexOf returns the position of the first element equal to value,
+// or -1 if there is none
+func IndexOf[T comparable](s Stream[T], value T) int {
+	index := 0
+	for element := range s.All() {
+		if element == value {
+			return index
+		}
+		index++
+	}
+	return -1
+}
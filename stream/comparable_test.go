@@ -0,0 +1,83 @@
+package stream_test
+
+import (
+	"testing"
+
+	"github.com/avila-r/ego/function"
+	"github.com/avila-r/ego/stream"
+	"github.com/stretchr/testify/assert"
+)
+
+type document struct {
+	id   string
+	tags []string
+}
+
+func Test_NonComparableElements(t *testing.T) {
+	docs := []document{
+		{"a", []string{"x"}},
+		{"b", []string{"x", "y"}},
+		{"c", nil},
+	}
+
+	tagged := stream.Of(docs...).Filter(function.NewPredicate(func(d document) bool {
+		return len(d.tags) > 0
+	}))
+
+	assert.Equal(t, docs[:2], tagged.ToSlice())
+
+	handlers := stream.Of(func() int { return 1 }, func() int { return 2 })
+	sum := stream.Map(handlers, function.NewFunction(func(f func() int) int { return f() })).
+		ReduceWithIdentity(0, function.NewBinaryOperator(func(a, b int) int { return a + b }))
+
+	assert.Equal(t, 3, sum)
+}
+
+func Test_DistinctBy(t *testing.T) {
+	docs := []document{
+		{"a", []string{"x"}},
+		{"b", nil},
+		{"a", []string{"y"}},
+	}
+
+	result := stream.DistinctBy(stream.Of(docs...), function.NewFunction(func(d document) string {
+		return d.id
+	}))
+
+	assert.Equal(t, []document{docs[0], docs[1]}, result.ToSlice())
+}
+
+func Test_Distinct_ShortCircuits(t *testing.T) {
+	naturals := stream.Iterate(0, function.NewUnaryOperator(func(n int) int { return n + 1 }))
+	modulo := stream.Map(naturals, function.NewFunction(func(n int) int { return n % 3 }))
+
+	assert.Equal(t, []int{0, 1, 2}, stream.Distinct(modulo).Limit(3).ToSlice())
+}
+
+func Test_Contains(t *testing.T) {
+	type Case struct {
+		name     string
+		elements []int
+		value    int
+		expected bool
+	}
+
+	cases := []Case{
+		{"empty stream", []int{}, 1, false},
+		{"present", []int{1, 2, 3}, 2, true},
+		{"absent", []int{1, 2, 3}, 4, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, stream.Contains(stream.Of(c.elements...), c.value))
+		})
+	}
+}
+
+func Test_IndexOf(t *testing.T) {
+	s := stream.Of("a", "b", "c", "b")
+
+	assert.Equal(t, 1, stream.IndexOf(s, "b"))
+	assert.Equal(t, -1, stream.IndexOf(s, "z"))
+}
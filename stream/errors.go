@@ -0,0 +1,13 @@
+package stream
+
+import (
+	"github.com/avila-r/ego"
+)
+
+var errors = ego.ExtendedGoErrorsNamespace.Class("stream")
+
+var (
+	ErrZeroStep        = errors.New("range step must not be zero")
+	ErrNonPositiveSize = errors.New("size and step must be positive")
+	ErrNotOrdered      = errors.New("element type has no natural order")
+)
@@ -0,0 +1,65 @@
+package stream
+
+import (
+	"github.com/avila-r/ego/constraint"
+	"github.com/avila-r/ego/function"
+	"github.com/avila-r/ego/optional"
+)
+
+// MapToInt maps every element to an int
+func (s Stream[T]) MapToInt(mapper function.ToIntFunction[T]) Stream[int] {
+	return Map(s, function.NewFunction(mapper.ApplyAsInt))
+}
+
+// MapToFloat maps every element to a float64
+func (s Stream[T]) MapToFloat(mapper function.ToFloatFunction[T]) Stream[float64] {
+	return Map(s, function.NewFunction(mapper.ApplyAsFloat))
+}
+
+// Sum adds up the elements of s. An empty stream sums to zero.
+func Sum[N constraint.Number](s Stream[N]) N {
+	return s.ReduceWithIdentity(0, function.NewBinaryOperator(func(a, b N) N {
+		return a + b
+	}))
+}
+
+// Average returns the arithmetic mean of the elements of s, or an empty
+// optional if s is empty
+func Average[N constraint.Number](s Stream[N]) optional.Optional[float64] {
+	statistics := Summarize(s)
+	if statistics.Count() == 0 {
+		return optional.Empty[float64]()
+	}
+	return optional.Of(statistics.Average())
+}
+
+// Min returns the smallest element of s, or an empty optional if s is empty
+func Min[N constraint.Number](s Stream[N]) optional.Optional[N] {
+	return s.Reduce(function.NewBinaryOperator(func(a, b N) N {
+		return min(a, b)
+	}))
+}
+
+// Max returns the largest element of s, or an empty optional if s is empty
+func Max[N constraint.Number](s Stream[N]) optional.Optional[N] {
+	return s.Reduce(function.NewBinaryOperator(func(a, b N) N {
+		return max(a, b)
+	}))
+}
+
+// Summarize records every element of s into a SummaryStatistics. Parallel
+// streams summarize each chunk separately and combine the results.
+func Summarize[N constraint.Number](s Stream[N]) *SummaryStatistics[N] {
+	return Collect(s, CollectorOf(
+		function.NewSupplier(func() *SummaryStatistics[N] {
+			return &SummaryStatistics[N]{}
+		}),
+		function.NewBiConsumer(func(statistics *SummaryStatistics[N], value N) {
+			statistics.Accept(value)
+		}),
+		function.NewBinaryOperator(func(a, b *SummaryStatistics[N]) *SummaryStatistics[N] {
+			a.Combine(b)
+			return a
+		}),
+	))
+}
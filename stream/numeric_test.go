@@ -0,0 +1,101 @@
+package stream_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/avila-r/ego/function"
+	"github.com/avila-r/ego/stream"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Sum(t *testing.T) {
+	assert.Equal(t, 10, stream.Sum(stream.Of(1, 2, 3, 4)))
+	assert.Equal(t, 0, stream.Sum(stream.Empty[int]()))
+	assert.InDelta(t, 0.6, stream.Sum(stream.Of(0.1, 0.2, 0.3)), 1e-9)
+	assert.Equal(t, 5050, stream.Sum(stream.Of(naturals(100)...).Parallel(4)))
+}
+
+func Test_Average(t *testing.T) {
+	average := stream.Average(stream.Of(1, 2, 3, 4))
+	assert.Equal(t, 2.5, average.Join())
+
+	empty := stream.Average(stream.Empty[int]())
+	assert.True(t, empty.IsEmpty())
+}
+
+func Test_NumericMinMax(t *testing.T) {
+	type Case struct {
+		name     string
+		elements []int
+		min, max int
+	}
+
+	cases := []Case{
+		{"single element", []int{7}, 7, 7},
+		{"mixed signs", []int{3, -2, 9, 0}, -2, 9},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			min, max := stream.Min(stream.Of(c.elements...)), stream.Max(stream.Of(c.elements...))
+			assert.Equal(t, c.min, min.Join())
+			assert.Equal(t, c.max, max.Join())
+		})
+	}
+
+	min, max := stream.Min(stream.Empty[int]()), stream.Max(stream.Empty[int]())
+	assert.True(t, min.IsEmpty())
+	assert.True(t, max.IsEmpty())
+}
+
+func Test_Summarize(t *testing.T) {
+	statistics := stream.Summarize(stream.Of(2, 4, 4, 4, 5, 5, 7, 9))
+
+	assert.Equal(t, int64(8), statistics.Count())
+	assert.Equal(t, 40, statistics.Sum())
+	assert.Equal(t, 2, statistics.Min())
+	assert.Equal(t, 9, statistics.Max())
+	assert.Equal(t, 5.0, statistics.Average())
+	assert.InDelta(t, 4.0, statistics.Variance(), 1e-9)
+	assert.InDelta(t, 32.0/7.0, statistics.SampleVariance(), 1e-9)
+	assert.InDelta(t, 2.0, statistics.StandardDeviation(), 1e-9)
+}
+
+func Test_Summarize_Empty(t *testing.T) {
+	statistics := stream.Summarize(stream.Empty[float64]())
+
+	assert.Equal(t, int64(0), statistics.Count())
+	assert.Equal(t, 0.0, statistics.Average())
+	assert.Equal(t, 0.0, statistics.Variance())
+	assert.Equal(t, 0.0, statistics.SampleVariance())
+}
+
+func Test_Summarize_Parallel(t *testing.T) {
+	sequential := stream.Summarize(stream.Of(naturals(5000)...))
+	parallel := stream.Summarize(stream.Of(naturals(5000)...).Parallel(4))
+
+	assert.Equal(t, sequential.Count(), parallel.Count())
+	assert.Equal(t, sequential.Sum(), parallel.Sum())
+	assert.Equal(t, sequential.Min(), parallel.Min())
+	assert.Equal(t, sequential.Max(), parallel.Max())
+	assert.InDelta(t, sequential.Average(), parallel.Average(), 1e-9)
+	assert.InDelta(t, sequential.Variance(), parallel.Variance(), 1e-6)
+}
+
+func Test_MapToInt(t *testing.T) {
+	lengths := stream.Of("a", "bb", "ccc").MapToInt(function.NewToIntFunction(func(s string) int {
+		return len(s)
+	}))
+
+	assert.Equal(t, 6, stream.Sum(lengths))
+}
+
+func Test_MapToFloat(t *testing.T) {
+	ratios := stream.Of("ab", "aab").MapToFloat(function.NewToFloatFunction(func(s string) float64 {
+		return float64(strings.Count(s, "a")) / float64(len(s))
+	}))
+
+	average := stream.Average(ratios)
+	assert.InDelta(t, 7.0/12.0, average.Join(), 1e-9)
+}
@@ -0,0 +1,214 @@
+package stream
+
+import (
+	"iter"
+	"runtime"
+	"sync"
+)
+
+// maxChunkSize caps how many upstream elements a worker receives at once.
+// Chunks start at a single element and double up to this size, so small
+// sources still spread across workers while large ones amortise the
+// hand-off cost.
+const maxChunkSize = 1024
+
+// parallelism configures how Map, Filter, Reduce and Collect are executed.
+// A zero value means the stream is sequential.
+type parallelism struct {
+	workers   int
+	unordered bool
+}
+
+func (p parallelism) enabled() bool {
+	return p.workers > 1
+}
+
+// Parallel makes Map, Filter, Reduce and Collect split the upstream elements
+// into chunks and process them on at most workers goroutines. A non-positive
+// workers value uses runtime.GOMAXPROCS, and a single worker keeps the stream
+// sequential. Encounter order is kept unless Unordered is requested.
+func (s Stream[T]) Parallel(workers int) Stream[T] {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	s.parallelism.workers = workers
+	return s
+}
+
+// Sequential makes the following stages run on the calling goroutine
+func (s Stream[T]) Sequential() Stream[T] {
+	s.parallelism.workers = 0
+	return s
+}
+
+// Unordered allows parallel stages to emit results, and Reduce and Collect
+// to combine partial results, in completion order instead of encounter order
+func (s Stream[T]) Unordered() Stream[T] {
+	s.parallelism.unordered = true
+	return s
+}
+
+// IsParallel reports whether the following stages run in parallel
+func (s Stream[T]) IsParallel() bool {
+	return s.parallelism.enabled()
+}
+
+type chunk[T any] struct {
+	index    int
+	value    T
+	panicked any
+}
+
+// runChunks splits upstream into chunks, applies process to each chunk on
+// up to p.workers goroutines and hands the processed chunks to yield. Chunks
+// are yielded in encounter order unless p.unordered is set. Returning false
+// from yield stops pulling upstream. A panic in process is re-raised on the
+// calling goroutine once all workers have stopped, and the workers stop too
+// when upstream or yield panics.
+func runChunks[T, R any](upstream iter.Seq[T], p parallelism, process func([]T) R, yield func(R) bool) {
+	limit := 2 * p.workers
+	jobs := make(chan chunk[[]T])
+	results := make(chan chunk[R], limit)
+
+	var wg sync.WaitGroup
+	for range p.workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				results <- processChunk(job, process)
+			}
+		}()
+	}
+
+	var (
+		index, next, inflight int
+		stopped, closed       bool
+		panicked              any
+		pending               = make(map[int]R)
+	)
+
+	// Upstream or yield may panic mid-run; the workers must still be
+	// released, so anything in flight is discarded on the way out
+	defer func() {
+		if !closed {
+			close(jobs)
+		}
+		for ; inflight > 0; inflight-- {
+			<-results
+		}
+		wg.Wait()
+	}()
+
+	deliver := func(result chunk[R]) {
+		if stopped {
+			return
+		}
+		if p.unordered {
+			stopped = !yield(result.value)
+			return
+		}
+		pending[result.index] = result.value
+		for value, ok := pending[next]; ok; value, ok = pending[next] {
+			delete(pending, next)
+			next++
+			if !yield(value) {
+				stopped = true
+				return
+			}
+		}
+	}
+
+	receive := func() {
+		result := <-results
+		inflight--
+		if result.panicked != nil && panicked == nil {
+			panicked, stopped = result.panicked, true
+		}
+		deliver(result)
+	}
+
+	submit := func(elements []T) {
+		for inflight >= limit && !stopped {
+			receive()
+		}
+		if stopped {
+			return
+		}
+		jobs <- chunk[[]T]{index: index, value: elements}
+		index++
+		inflight++
+	}
+
+	size := 1
+	buffer := make([]T, 0, size)
+	for element := range upstream {
+		buffer = append(buffer, element)
+		if len(buffer) < size {
+			continue
+		}
+		submit(buffer)
+		if stopped {
+			break
+		}
+		size = min(size*2, maxChunkSize)
+		buffer = make([]T, 0, size)
+	}
+	if len(buffer) > 0 && !stopped {
+		submit(buffer)
+	}
+
+	close(jobs)
+	closed = true
+	for inflight > 0 {
+		receive()
+	}
+
+	if panicked != nil {
+		panic(panicked)
+	}
+}
+
+func processChunk[T, R any](job chunk[[]T], process func([]T) R) (result chunk[R]) {
+	result.index = job.index
+	defer func() {
+		if r := recover(); r != nil {
+			result.panicked = r
+		}
+	}()
+	result.value = process(job.value)
+	return result
+}
+
+// parallelSeq runs process over chunks of upstream and flattens the
+// processed chunks back into a single sequence
+func parallelSeq[T, R any](upstream iter.Seq[T], p parallelism, process func([]T) []R) iter.Seq[R] {
+	return func(yield func(R) bool) {
+		runChunks(upstream, p, process, func(elements []R) bool {
+			for _, element := range elements {
+				if !yield(element) {
+					return false
+				}
+			}
+			return true
+		})
+	}
+}
+
+// parallelFold folds each chunk into a partial result and merges the
+// partial results with combine, in encounter order unless p.unordered is set
+func parallelFold[T, A any](upstream iter.Seq[T], p parallelism, fold func([]T) A, combine func(A, A) A) (A, bool) {
+	var (
+		result A
+		found  bool
+	)
+	runChunks(upstream, p, fold, func(partial A) bool {
+		if !found {
+			result, found = partial, true
+			return true
+		}
+		result = combine(result, partial)
+		return true
+	})
+	return result, found
+}
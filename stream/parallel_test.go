@@ -0,0 +1,208 @@
+package stream_test
+
+import (
+	"runtime"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/avila-r/ego/function"
+	"github.com/avila-r/ego/stream"
+	"github.com/stretchr/testify/assert"
+)
+
+func naturals(n int) []int {
+	result := make([]int, n)
+	for i := range result {
+		result[i] = i + 1
+	}
+	return result
+}
+
+func sliceCollector() stream.Collector[int, *[]int, []int] {
+	return stream.NewCollector(
+		function.NewSupplier(func() *[]int { return &[]int{} }),
+		function.NewBiConsumer(func(acc *[]int, v int) { *acc = append(*acc, v) }),
+		function.NewBinaryOperator(func(a, b *[]int) *[]int {
+			*a = append(*a, *b...)
+			return a
+		}),
+		function.NewFunction(func(acc *[]int) []int { return *acc }),
+	)
+}
+
+func Test_Parallel_Map_KeepsOrder(t *testing.T) {
+	type Case struct {
+		name    string
+		size    int
+		workers int
+	}
+
+	cases := []Case{
+		{"empty source", 0, 4},
+		{"smaller than workers", 3, 8},
+		{"many chunks", 5000, 4},
+		{"default workers", 100, 0},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			source := naturals(c.size)
+			s := stream.Map(stream.Of(source...).Parallel(c.workers), function.NewFunction(func(n int) int {
+				return n * 2
+			}))
+
+			expected := make([]int, 0, c.size)
+			for _, n := range source {
+				expected = append(expected, n*2)
+			}
+
+			assert.Equal(t, c.workers > 1 || runtime.GOMAXPROCS(0) > 1, s.IsParallel())
+			assert.Equal(t, expected, s.ToSlice())
+		})
+	}
+}
+
+func Test_Parallel_Filter(t *testing.T) {
+	s := stream.Of(naturals(1000)...).Parallel(4).Filter(function.NewPredicate(isEven))
+
+	assert.Equal(t, int64(500), s.Count())
+	assert.Equal(t, []int{2, 4, 6}, s.Limit(3).ToSlice())
+}
+
+func Test_Parallel_UsesWorkers(t *testing.T) {
+	var running, peak atomic.Int32
+	s := stream.Map(stream.Of(naturals(64)...).Parallel(4), function.NewFunction(func(n int) int {
+		current := running.Add(1)
+		for {
+			observed := peak.Load()
+			if current <= observed || peak.CompareAndSwap(observed, current) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		running.Add(-1)
+		return n
+	}))
+
+	s.ToSlice()
+
+	assert.Greater(t, peak.Load(), int32(1))
+	assert.LessOrEqual(t, peak.Load(), int32(4))
+}
+
+func Test_Parallel_Unordered(t *testing.T) {
+	source := naturals(2000)
+	s := stream.Map(stream.Of(source...).Parallel(4).Unordered(), function.NewFunction(func(n int) int {
+		return n
+	}))
+
+	result := s.ToSlice()
+	slices.Sort(result)
+
+	assert.Equal(t, source, result)
+}
+
+func Test_Parallel_Reduce(t *testing.T) {
+	sum := function.NewBinaryOperator(func(a, b int) int { return a + b })
+	s := stream.Of(naturals(1000)...).Parallel(4)
+
+	reduced := s.Reduce(sum)
+	assert.Equal(t, 500500, reduced.Join())
+	assert.Equal(t, 500500, s.ReduceWithIdentity(0, sum))
+
+	empty := stream.Empty[int]().Parallel(4).Reduce(sum)
+	assert.True(t, empty.IsEmpty())
+	assert.Equal(t, 0, stream.Empty[int]().Parallel(4).ReduceWithIdentity(0, sum))
+}
+
+func Test_Parallel_Collect_UsesCombiner(t *testing.T) {
+	source := naturals(3000)
+
+	result := stream.Collect(stream.Of(source...).Parallel(4), sliceCollector())
+	assert.Equal(t, source, result)
+
+	empty := stream.Collect(stream.Empty[int]().Parallel(4), sliceCollector())
+	assert.Equal(t, []int{}, empty)
+}
+
+func Test_Parallel_Collect_WithoutCombiner(t *testing.T) {
+	collector := stream.NewCollector(
+		function.NewSupplier(func() *[]int { return &[]int{} }),
+		function.NewBiConsumer(func(acc *[]int, v int) { *acc = append(*acc, v) }),
+		nil,
+		function.NewFunction(func(acc *[]int) []int { return *acc }),
+	)
+
+	assert.Equal(t, []int{1, 2, 3}, stream.Collect(stream.Of(1, 2, 3).Parallel(4), collector))
+}
+
+func Test_Parallel_ShortCircuits(t *testing.T) {
+	var mu sync.Mutex
+	seen := 0
+	s := stream.Map(stream.Iterate(1, function.NewUnaryOperator(func(n int) int { return n + 1 })).Parallel(4),
+		function.NewFunction(func(n int) int {
+			mu.Lock()
+			seen++
+			mu.Unlock()
+			return n
+		}))
+
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, s.Limit(5).ToSlice())
+	assert.Less(t, seen, 10000)
+}
+
+func Test_Parallel_PropagatesPanics(t *testing.T) {
+	s := stream.Map(stream.Of(naturals(100)...).Parallel(4), function.NewFunction(func(n int) int {
+		if n == 50 {
+			panic("boom")
+		}
+		return n
+	}))
+
+	assert.PanicsWithValue(t, "boom", func() {
+		s.ToSlice()
+	})
+}
+
+func Test_Parallel_ReleasesWorkersOnPanic(t *testing.T) {
+	double := function.NewFunction(func(n int) int { return n * 2 })
+	before := runtime.NumGoroutine()
+
+	t.Run("downstream", func(t *testing.T) {
+		s := stream.Map(stream.Of(naturals(10000)...).Parallel(4), double)
+		assert.PanicsWithValue(t, "downstream", func() {
+			for range s.All() {
+				panic("downstream")
+			}
+		})
+	})
+
+	t.Run("upstream", func(t *testing.T) {
+		source := stream.FromSeq(func(yield func(int) bool) {
+			for n := range 10000 {
+				if n == 5000 {
+					panic("upstream")
+				}
+				if !yield(n) {
+					return
+				}
+			}
+		})
+		assert.PanicsWithValue(t, "upstream", func() {
+			stream.Map(source.Parallel(4), double).ToSlice()
+		})
+	})
+
+	// runChunks waits for its workers before returning, even when panicking
+	assert.Equal(t, before, runtime.NumGoroutine())
+}
+
+func Test_Sequential(t *testing.T) {
+	s := stream.Of(1, 2, 3).Parallel(4).Sequential()
+
+	assert.False(t, s.IsParallel())
+	assert.Equal(t, []int{1, 2, 3}, s.ToSlice())
+}
@@ -0,0 +1,105 @@
+package stream
+
+import "sync"
+
+// resources holds the close handlers and the source error shared by every
+// stage derived from the same pipeline
+type resources struct {
+	mu       sync.Mutex
+	handlers []func() error
+	err      error
+	closed   bool
+	parents  []*resources
+}
+
+// fail records the first error reported by a source
+func (r *resources) fail(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err == nil {
+		r.err = err
+	}
+}
+
+func (r *resources) error() error {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	err := r.err
+	r.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	for _, parent := range r.parents {
+		if err := parent.error(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// close runs every handler once, in registration order, then closes the
+// parent pipelines. All handlers run even if one fails.
+func (r *resources) close() error {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	if r.closed {
+		r.mu.Unlock()
+		return nil
+	}
+	r.closed = true
+	handlers := r.handlers
+	r.mu.Unlock()
+
+	var failures []error
+	for _, handler := range handlers {
+		if err := handler(); err != nil {
+			failures = append(failures, err)
+		}
+	}
+	for _, parent := range r.parents {
+		if err := parent.close(); err != nil {
+			failures = append(failures, err)
+		}
+	}
+
+	if len(failures) == 0 {
+		return nil
+	}
+	return errors.Wrap(failures[0], "failed to close stream").Also(failures[1:]...)
+}
+
+// withResources makes sure the stream has resources that later stages share
+func (s Stream[T]) withResources() Stream[T] {
+	if s.resources == nil {
+		s.resources = &resources{}
+	}
+	return s
+}
+
+// OnClose registers a handler that runs when Close is called on this stream
+// or on any stream derived from it. Handlers run in registration order.
+func (s Stream[T]) OnClose(handler func() error) Stream[T] {
+	s = s.withResources()
+	s.resources.mu.Lock()
+	s.resources.handlers = append(s.resources.handlers, handler)
+	s.resources.mu.Unlock()
+	return s
+}
+
+// Close runs the registered close handlers. Streams are not closed by
+// terminal operations; sources such as FromFile must be closed explicitly.
+// Calling Close more than once has no further effect.
+func (s Stream[T]) Close() error {
+	return s.resources.close()
+}
+
+// Err returns the first error reported by the stream's sources, such as a
+// failure to open or read a file. It is meaningful after a terminal
+// operation has run.
+func (s Stream[T]) Err() error {
+	return s.resources.error()
+}
@@ -0,0 +1,111 @@
+package stream
+
+import (
+	"github.com/avila-r/ego/function"
+	"github.com/avila-r/ego/result"
+)
+
+// TryMap applies a fallible mapper to each element. Failures do not stop the
+// stream; they become error results that later stages and terminal
+// operations such as CollectResults decide how to handle.
+func TryMap[T, R any](s Stream[T], mapper func(T) (R, error)) Stream[result.Result[R]] {
+	return Map(s, function.NewFunction(func(element T) result.Result[R] {
+		value, err := mapper(element)
+		if err != nil {
+			return result.Error[R](err)
+		}
+		return result.Ok(value)
+	}))
+}
+
+// TryFilter keeps the elements for which a fallible predicate returns true.
+// A predicate failure is kept in the stream as an error result.
+func TryFilter[T any](s Stream[T], predicate func(T) (bool, error)) Stream[result.Result[T]] {
+	return derive(s, func(yield func(result.Result[T]) bool) {
+		for element := range s.All() {
+			keep, err := predicate(element)
+			switch {
+			case err != nil:
+				if !yield(result.Error[T](err)) {
+					return
+				}
+			case keep:
+				if !yield(result.Ok(element)) {
+					return
+				}
+			}
+		}
+	})
+}
+
+// AndThenTry applies a fallible mapper to the successful results of s.
+// Error results pass through unchanged.
+func AndThenTry[T, R any](s Stream[result.Result[T]], mapper func(T) (R, error)) Stream[result.Result[R]] {
+	return Map(s, function.NewFunction(func(r result.Result[T]) result.Result[R] {
+		if r.IsError() {
+			return result.Error[R](r.Error())
+		}
+		value, err := mapper(r.Unwrap())
+		if err != nil {
+			return result.Error[R](err)
+		}
+		return result.Ok(value)
+	}))
+}
+
+// SkipErrors drops error results, passing each error to onError when it is
+// not nil, and unwraps the successful ones
+func SkipErrors[T any](s Stream[result.Result[T]], onError func(error)) Stream[T] {
+	return derive(s, func(yield func(T) bool) {
+		for r := range s.All() {
+			if r.IsError() {
+				if onError != nil {
+					onError(r.Error())
+				}
+				continue
+			}
+			if !yield(r.Unwrap()) {
+				return
+			}
+		}
+	})
+}
+
+// CollectResults gathers the successful values of s in order. It stops
+// pulling from upstream at the first error result and returns that error.
+func CollectResults[T any](s Stream[result.Result[T]]) result.Result[[]T] {
+	values := make([]T, 0)
+	for r := range s.All() {
+		if r.IsError() {
+			return result.Error[[]T](r.Error())
+		}
+		values = append(values, r.Unwrap())
+	}
+	return result.Ok(values)
+}
+
+// CollectAllResults consumes the whole stream. If any element failed it
+// returns a single failure whose underlying errors are every error result,
+// in encounter order; otherwise it returns the successful values.
+func CollectAllResults[T any](s Stream[result.Result[T]]) result.Result[[]T] {
+	values, failures := PartitionResults(s)
+	if len(failures) > 0 {
+		return result.Error[[]T](errors.New("%d of %d elements failed", len(failures), len(values)+len(failures)).Also(failures...))
+	}
+	return result.Ok(values)
+}
+
+// PartitionResults consumes the whole stream and splits it into successful
+// values and errors, each in encounter order
+func PartitionResults[T any](s Stream[result.Result[T]]) ([]T, []error) {
+	values := make([]T, 0)
+	failures := make([]error, 0)
+	for r := range s.All() {
+		if r.IsError() {
+			failures = append(failures, r.Error())
+			continue
+		}
+		values = append(values, r.Unwrap())
+	}
+	return values, failures
+}
@@ -0,0 +1,128 @@
+package stream_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/avila-r/ego/failure"
+	"github.com/avila-r/ego/function"
+	"github.com/avila-r/ego/stream"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_TryMap(t *testing.T) {
+	s := stream.TryMap(stream.Of("1", "x", "3"), strconv.Atoi)
+
+	results := s.ToSlice()
+	assert.Len(t, results, 3)
+	assert.True(t, results[0].IsSuccess())
+	assert.Equal(t, 1, results[0].Unwrap())
+	assert.True(t, results[1].IsError())
+	assert.Equal(t, 3, results[2].Unwrap())
+}
+
+func Test_TryFilter(t *testing.T) {
+	cause := errors.New("cannot decide")
+	s := stream.TryFilter(stream.Of(1, 2, 3, 4), func(n int) (bool, error) {
+		if n == 3 {
+			return false, cause
+		}
+		return isEven(n), nil
+	})
+
+	values, failures := stream.PartitionResults(s)
+	assert.Equal(t, []int{2, 4}, values)
+	assert.Equal(t, []error{cause}, failures)
+}
+
+func Test_AndThenTry(t *testing.T) {
+	parsed := stream.TryMap(stream.Of("4", "x", "0"), strconv.Atoi)
+	inverted := stream.AndThenTry(parsed, func(n int) (float64, error) {
+		if n == 0 {
+			return 0, errors.New("division by zero")
+		}
+		return 1 / float64(n), nil
+	})
+
+	values, failures := stream.PartitionResults(inverted)
+	assert.Equal(t, []float64{0.25}, values)
+	assert.Len(t, failures, 2)
+	assert.Equal(t, "division by zero", failures[1].Error())
+}
+
+func Test_CollectResults(t *testing.T) {
+	type Case struct {
+		name     string
+		input    []string
+		expected []int
+		fails    bool
+	}
+
+	cases := []Case{
+		{"empty stream", []string{}, []int{}, false},
+		{"all succeed", []string{"1", "2"}, []int{1, 2}, false},
+		{"one fails", []string{"1", "x", "3"}, nil, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			collected := stream.CollectResults(stream.TryMap(stream.Of(c.input...), strconv.Atoi))
+
+			assert.Equal(t, c.fails, collected.IsError())
+			if !c.fails {
+				assert.Equal(t, c.expected, collected.Unwrap())
+			}
+		})
+	}
+}
+
+func Test_CollectResults_StopsAtFirstError(t *testing.T) {
+	pulled := 0
+	source := stream.Of("1", "x", "3", "y").Peek(function.NewConsumer(func(string) { pulled++ }))
+
+	collected := stream.CollectResults(stream.TryMap(source, strconv.Atoi))
+
+	assert.True(t, collected.IsError())
+	assert.ErrorIs(t, collected.Error(), strconv.ErrSyntax)
+	assert.Equal(t, 2, pulled)
+}
+
+func Test_CollectAllResults(t *testing.T) {
+	collected := stream.CollectAllResults(stream.TryMap(stream.Of("1", "x", "3", "y"), strconv.Atoi))
+
+	assert.True(t, collected.IsError())
+	aggregate := failure.Cast(collected.Error())
+	assert.NotNil(t, aggregate)
+	assert.Equal(t, "2 of 4 elements failed", aggregate.Error())
+	assert.Len(t, aggregate.Underlying(), 2)
+
+	succeeded := stream.CollectAllResults(stream.TryMap(stream.Of("1", "2"), strconv.Atoi))
+	assert.Equal(t, []int{1, 2}, succeeded.Unwrap())
+}
+
+func Test_SkipErrors(t *testing.T) {
+	var skipped []error
+	s := stream.SkipErrors(stream.TryMap(stream.Of("1", "x", "3"), strconv.Atoi), func(err error) {
+		skipped = append(skipped, err)
+	})
+
+	assert.Equal(t, []int{1, 3}, s.ToSlice())
+	assert.Len(t, skipped, 1)
+
+	quiet := stream.SkipErrors(stream.TryMap(stream.Of("x", "2"), strconv.Atoi), nil)
+	assert.Equal(t, []int{2}, quiet.ToSlice())
+}
+
+func Test_TryMap_Parallel(t *testing.T) {
+	inputs := make([]string, 1000)
+	for i := range inputs {
+		inputs[i] = strconv.Itoa(i)
+	}
+
+	collected := stream.CollectResults(stream.TryMap(stream.Of(inputs...).Parallel(4), strconv.Atoi))
+
+	values := collected.Unwrap()
+	assert.Len(t, values, 1000)
+	assert.Equal(t, 999, values[999])
+}
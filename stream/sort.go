@@ -0,0 +1,173 @@
+package stream
+
+import (
+	"cmp"
+	"container/heap"
+	"math/rand"
+	"slices"
+
+	"github.com/avila-r/ego/constraint"
+	"github.com/avila-r/ego/function"
+)
+
+// Sort is a stateful stage: it buffers every upstream element into a
+// private slice and emits them in natural order. Elements must be numbers,
+// strings or implement Compare(T) int, otherwise Sort panics with
+// ErrNotOrdered. The sort is stable.
+func (s Stream[T]) Sort() Stream[T] {
+	compare := naturalOrder[T]()
+
+	return s.pipe(func(yield func(T) bool) {
+		elements := s.ToSlice()
+		slices.SortStableFunc(elements, compare)
+
+		emit(elements, yield)
+	})
+}
+
+// Sorted is a stateful stage: it buffers every upstream element into a
+// private slice before emitting them in comparator order. The sort is
+// stable, so equal elements keep their encounter order.
+func (s Stream[T]) Sorted(comparator function.Comparator[T]) Stream[T] {
+	return s.pipe(func(yield func(T) bool) {
+		elements := s.ToSlice()
+		slices.SortStableFunc(elements, comparator.Compare)
+
+		emit(elements, yield)
+	})
+}
+
+// SortedBy stably sorts the elements of s by the key keyExtractor derives
+// from each of them. Every key is computed exactly once.
+func SortedBy[T any, K constraint.Ordered](s Stream[T], keyExtractor function.Function[T, K]) Stream[T] {
+	type keyed struct {
+		key     K
+		element T
+	}
+
+	return s.pipe(func(yield func(T) bool) {
+		elements := make([]keyed, 0)
+		for element := range s.All() {
+			elements = append(elements, keyed{keyExtractor.Apply(element), element})
+		}
+
+		slices.SortStableFunc(elements, func(a, b keyed) int {
+			return cmp.Compare(a.key, b.key)
+		})
+
+		for _, e := range elements {
+			if !yield(e.element) {
+				return
+			}
+		}
+	})
+}
+
+// Reversed is a stateful stage that emits the elements of s from last to
+// first
+func (s Stream[T]) Reversed() Stream[T] {
+	return s.pipe(func(yield func(T) bool) {
+		elements := s.ToSlice()
+		slices.Reverse(elements)
+
+		emit(elements, yield)
+	})
+}
+
+// TopK emits the k greatest elements of s according to comparator, greatest
+// first. Only k elements are held in memory at a time; among equal elements
+// the earliest ones are kept. A k of zero or less yields an empty stream.
+func (s Stream[T]) TopK(k int, comparator function.Comparator[T]) Stream[T] {
+	return s.pipe(func(yield func(T) bool) {
+		if k <= 0 {
+			return
+		}
+
+		h := &rankedHeap[T]{compare: comparator.Compare}
+		index := 0
+		for element := range s.All() {
+			candidate := ranked[T]{element, index}
+			index++
+
+			if h.Len() < k {
+				heap.Push(h, candidate)
+				continue
+			}
+			if h.less(h.items[0], candidate) {
+				h.items[0] = candidate
+				heap.Fix(h, 0)
+			}
+		}
+
+		elements := make([]T, h.Len())
+		for i := len(elements) - 1; i >= 0; i-- {
+			elements[i] = heap.Pop(h).(ranked[T]).element
+		}
+
+		emit(elements, yield)
+	})
+}
+
+// Shuffle is a stateful stage that emits the elements of s in a random order
+// drawn from source. The same source seed always yields the same order.
+func (s Stream[T]) Shuffle(source rand.Source) Stream[T] {
+	return s.pipe(func(yield func(T) bool) {
+		elements := s.ToSlice()
+		rand.New(source).Shuffle(len(elements), func(i, j int) {
+			elements[i], elements[j] = elements[j], elements[i]
+		})
+
+		emit(elements, yield)
+	})
+}
+
+type ranked[T any] struct {
+	element T
+	index   int
+}
+
+// rankedHeap is a min-heap where, among equal elements, later ones rank lower
+// so they are evicted first
+type rankedHeap[T any] struct {
+	items   []ranked[T]
+	compare func(a, b T) int
+}
+
+func (h *rankedHeap[T]) Len() int {
+	return len(h.items)
+}
+
+func (h *rankedHeap[T]) Less(i, j int) bool {
+	return h.less(h.items[i], h.items[j])
+}
+
+func (h *rankedHeap[T]) less(a, b ranked[T]) bool {
+	if c := h.compare(a.element, b.element); c != 0 {
+		return c < 0
+	}
+	return a.index > b.index
+}
+
+func (h *rankedHeap[T]) Swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+}
+
+func (h *rankedHeap[T]) Push(x any) {
+	h.items = append(h.items, x.(ranked[T]))
+}
+
+func (h *rankedHeap[T]) Pop() any {
+	last := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	return last
+}
+
+// naturalOrder resolves how values of T compare to each other, panicking
+// with ErrNotOrdered if they don't
+func naturalOrder[T any]() func(a, b T) int {
+	comparator, ok := function.NaturalOrderOf[T]()
+	if !ok {
+		ErrNotOrdered.Panic()
+	}
+	return comparator.Compare
+}
@@ -0,0 +1,150 @@
+package stream_test
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/avila-r/ego/function"
+	"github.com/avila-r/ego/stream"
+	"github.com/stretchr/testify/assert"
+)
+
+type version struct {
+	major, minor int
+}
+
+func (v version) Compare(other version) int {
+	if v.major != other.major {
+		return v.major - other.major
+	}
+	return v.minor - other.minor
+}
+
+type celsius float64
+
+type priority int8
+
+type label string
+
+type word struct {
+	text  string
+	order int
+}
+
+var byLength = function.NewComparator(func(a, b word) int {
+	return len(a.text) - len(b.text)
+})
+
+func Test_Sort(t *testing.T) {
+	t.Run("integers", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2, 3, 5}, stream.Of(3, 5, 1, 2).Sort().ToSlice())
+	})
+
+	t.Run("strings", func(t *testing.T) {
+		assert.Equal(t, []string{"a", "b", "c"}, stream.Of("c", "a", "b").Sort().ToSlice())
+	})
+
+	t.Run("named types", func(t *testing.T) {
+		assert.Equal(t, []celsius{-4, 0.5, 21}, stream.Of[celsius](21, -4, 0.5).Sort().ToSlice())
+		assert.Equal(t, []priority{-128, 0, 127}, stream.Of[priority](127, -128, 0).Sort().ToSlice())
+		assert.Equal(t, []label{"a", "ab", "b"}, stream.Of[label]("b", "ab", "a").Sort().ToSlice())
+	})
+
+	t.Run("compare method", func(t *testing.T) {
+		sorted := stream.Of(version{1, 2}, version{0, 9}, version{1, 0}).Sort().ToSlice()
+		assert.Equal(t, []version{{0, 9}, {1, 0}, {1, 2}}, sorted)
+	})
+
+	t.Run("unordered type", func(t *testing.T) {
+		assert.Panics(t, func() {
+			stream.Of(word{}, word{}).Sort()
+		})
+	})
+}
+
+func Test_Sort_DoesNotMutateSource(t *testing.T) {
+	source := []int{3, 1, 2}
+
+	assert.Equal(t, []int{1, 2, 3}, stream.Of(source...).Sort().ToSlice())
+	assert.Equal(t, []int{3, 1, 2}, source)
+}
+
+func Test_Sorted_IsStable(t *testing.T) {
+	words := []word{{"ccc", 0}, {"a", 1}, {"bb", 2}, {"b", 3}, {"aa", 4}}
+
+	sorted := stream.Of(words...).Sorted(byLength).ToSlice()
+
+	assert.Equal(t, []word{{"a", 1}, {"b", 3}, {"bb", 2}, {"aa", 4}, {"ccc", 0}}, sorted)
+}
+
+func Test_SortedBy(t *testing.T) {
+	calls := 0
+	sorted := stream.SortedBy(stream.Of("Banana", "apple", "Cherry"), function.NewFunction(func(s string) string {
+		calls++
+		return strings.ToLower(s)
+	})).ToSlice()
+
+	assert.Equal(t, []string{"apple", "Banana", "Cherry"}, sorted)
+	assert.Equal(t, 3, calls)
+}
+
+func Test_Reversed(t *testing.T) {
+	assert.Equal(t, []int{3, 2, 1}, stream.Of(1, 2, 3).Reversed().ToSlice())
+	assert.Equal(t, []int{}, stream.Empty[int]().Reversed().ToSlice())
+}
+
+func Test_TopK(t *testing.T) {
+	comparator := function.NewComparator(func(a, b int) int { return a - b })
+
+	type Case struct {
+		name     string
+		elements []int
+		k        int
+		expected []int
+	}
+
+	cases := []Case{
+		{"top three", []int{5, 1, 9, 3, 7, 2}, 3, []int{9, 7, 5}},
+		{"k larger than stream", []int{2, 1}, 5, []int{2, 1}},
+		{"zero k", []int{1, 2}, 0, []int{}},
+		{"duplicates", []int{4, 4, 1, 4}, 2, []int{4, 4}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, stream.Of(c.elements...).TopK(c.k, comparator).ToSlice())
+		})
+	}
+}
+
+func Test_TopK_KeepsEarliestTies(t *testing.T) {
+	words := []word{{"aa", 0}, {"b", 1}, {"cc", 2}, {"dd", 3}, {"eee", 4}}
+
+	top := stream.Of(words...).TopK(3, byLength).ToSlice()
+
+	assert.Equal(t, []word{{"eee", 4}, {"aa", 0}, {"cc", 2}}, top)
+}
+
+func Test_Shuffle(t *testing.T) {
+	elements := naturals(50)
+
+	first := stream.Of(elements...).Shuffle(rand.NewSource(42)).ToSlice()
+	second := stream.Of(elements...).Shuffle(rand.NewSource(42)).ToSlice()
+
+	assert.Equal(t, first, second)
+	assert.NotEqual(t, elements, first)
+	assert.ElementsMatch(t, elements, first)
+	assert.Equal(t, naturals(50), elements)
+}
+
+func Test_ForEachOrdered(t *testing.T) {
+	var visited []word
+	words := []word{{"b", 0}, {"a", 1}}
+
+	stream.Of(words...).ForEachOrdered(function.NewConsumer(func(w word) {
+		visited = append(visited, w)
+	}))
+
+	assert.Equal(t, words, visited)
+}
@@ -0,0 +1,97 @@
+package stream
+
+import (
+	"bufio"
+	"io"
+	"iter"
+	"os"
+
+	"github.com/avila-r/ego/constraint"
+	"github.com/avila-r/ego/pair"
+)
+
+// maxLineSize bounds how long a single line read by Lines may be
+const maxLineSize = 64 * 1024 * 1024
+
+// Lines creates a stream over the lines of reader, without line terminators.
+// Lines are read as the pipeline pulls them, so the whole input is never
+// held in memory. A read error ends the stream and is reported by Err.
+// The reader can only be consumed once.
+func Lines(reader io.Reader) Stream[string] {
+	s := Stream[string]{resources: &resources{}}
+	s.seq = func(yield func(string) bool) {
+		scanner := bufio.NewScanner(reader)
+		scanner.Buffer(nil, maxLineSize)
+		for scanner.Scan() {
+			if !yield(scanner.Text()) {
+				return
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			s.resources.fail(errors.Wrap(err, "failed to read lines"))
+		}
+	}
+	return s
+}
+
+// FromFile creates a stream over the lines of the file at path. The file is
+// opened immediately and released by Close. Open and read errors end the
+// stream and are reported by Err.
+func FromFile(path string) Stream[string] {
+	file, err := os.Open(path)
+	if err != nil {
+		s := Empty[string]().withResources()
+		s.resources.fail(errors.Wrap(err, "failed to open %s", path))
+		return s
+	}
+	return Lines(file).OnClose(file.Close)
+}
+
+// FromChannel creates a stream that receives from channel until it is closed
+func FromChannel[T any](channel <-chan T) Stream[T] {
+	return Stream[T]{seq: func(yield func(T) bool) {
+		for element := range channel {
+			if !yield(element) {
+				return
+			}
+		}
+	}}
+}
+
+// Range creates a stream from start up to, but not including, end, moving
+// by step. A negative step counts down. Range panics with ErrZeroStep if
+// step is zero.
+func Range[N constraint.Integer](start, end, step N) Stream[N] {
+	if step == 0 {
+		ErrZeroStep.Panic()
+	}
+	return Stream[N]{seq: func(yield func(N) bool) {
+		ascending := step > 0
+		for current := start; (ascending && current < end) || (!ascending && current > end); {
+			if !yield(current) {
+				return
+			}
+			next := current + step
+			if ascending != (next > current) {
+				return // overflow
+			}
+			current = next
+		}
+	}}
+}
+
+// FromSeq creates a stream over a Go range-over-func sequence
+func FromSeq[T any](seq iter.Seq[T]) Stream[T] {
+	return Stream[T]{seq: seq}
+}
+
+// FromMap creates a stream over the key/value pairs of m, in map iteration order
+func FromMap[M ~map[K]V, K comparable, V any](m M) Stream[pair.EntryPair[K, V]] {
+	return Stream[pair.EntryPair[K, V]]{seq: func(yield func(pair.EntryPair[K, V]) bool) {
+		for k, v := range m {
+			if !yield(pair.EntryPair[K, V]{Key: k, Value: v}) {
+				return
+			}
+		}
+	}}
+}
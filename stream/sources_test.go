@@ -0,0 +1,194 @@
+package stream_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/avila-r/ego/failure"
+	"github.com/avila-r/ego/function"
+	"github.com/avila-r/ego/pair"
+	"github.com/avila-r/ego/stream"
+	"github.com/stretchr/testify/assert"
+)
+
+type failingReader struct {
+	data string
+	err  error
+}
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	if r.data == "" {
+		return 0, r.err
+	}
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
+func Test_Lines(t *testing.T) {
+	type Case struct {
+		name     string
+		input    string
+		expected []string
+	}
+
+	cases := []Case{
+		{"empty input", "", []string{}},
+		{"single line", "a", []string{"a"}},
+		{"trailing newline", "a\nb\n", []string{"a", "b"}},
+		{"windows line endings", "a\r\nb", []string{"a", "b"}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			s := stream.Lines(strings.NewReader(c.input))
+
+			assert.Equal(t, c.expected, s.ToSlice())
+			assert.NoError(t, s.Err())
+		})
+	}
+}
+
+func Test_Lines_ReadError(t *testing.T) {
+	cause := errors.New("disk on fire")
+	s := stream.Lines(&failingReader{data: "a\nb\n", err: cause}).
+		Filter(function.NewPredicate(func(line string) bool { return line != "" }))
+
+	assert.Equal(t, []string{"a", "b"}, s.ToSlice())
+	assert.Error(t, s.Err())
+	assert.Equal(t, cause, failure.Cast(s.Err()).Cause())
+}
+
+func Test_FromFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	assert.NoError(t, os.WriteFile(path, []byte("INFO start\nERROR boom\nINFO stop\n"), 0o600))
+
+	s := stream.FromFile(path)
+	errorsOnly := s.Filter(function.NewPredicate(func(line string) bool {
+		return strings.HasPrefix(line, "ERROR")
+	}))
+
+	assert.Equal(t, []string{"ERROR boom"}, errorsOnly.ToSlice())
+	assert.NoError(t, errorsOnly.Err())
+	assert.NoError(t, errorsOnly.Close())
+	assert.NoError(t, s.Close())
+}
+
+func Test_FromFile_Missing(t *testing.T) {
+	s := stream.FromFile(filepath.Join(t.TempDir(), "missing.log"))
+
+	assert.Equal(t, []string{}, s.ToSlice())
+	assert.Error(t, s.Err())
+	assert.ErrorIs(t, failure.Cast(s.Err()).Cause(), os.ErrNotExist)
+	assert.NoError(t, s.Close())
+}
+
+func Test_OnClose(t *testing.T) {
+	var closed []string
+	s := stream.Of(1, 2, 3).
+		OnClose(func() error { closed = append(closed, "first"); return nil }).
+		OnClose(func() error { closed = append(closed, "second"); return errors.New("second failed") })
+
+	mapped := stream.Map(s, function.NewFunction(func(n int) int { return n * 2 }))
+	assert.Equal(t, []int{2, 4, 6}, mapped.ToSlice())
+
+	// Terminal operations do not close the stream
+	assert.Empty(t, closed)
+
+	err := mapped.Close()
+	assert.Error(t, err)
+	assert.Equal(t, []string{"first", "second"}, closed)
+
+	// Closing again is a no-op
+	assert.NoError(t, s.Close())
+	assert.Equal(t, []string{"first", "second"}, closed)
+}
+
+func Test_Concat_ClosesBoth(t *testing.T) {
+	closed := 0
+	handler := func() error { closed++; return nil }
+
+	s := stream.Concat(stream.Of(1).OnClose(handler), stream.Of(2).OnClose(handler))
+
+	assert.Equal(t, []int{1, 2}, s.ToSlice())
+	assert.NoError(t, s.Close())
+	assert.Equal(t, 2, closed)
+}
+
+func Test_FlatMap_ClosesInnerStreams(t *testing.T) {
+	closed := 0
+	s := stream.FlatMap(stream.Of("a\nb", "c"), function.NewFunction(func(text string) stream.Stream[string] {
+		return stream.Lines(strings.NewReader(text)).OnClose(func() error { closed++; return nil })
+	}))
+
+	assert.Equal(t, []string{"a", "b", "c"}, s.ToSlice())
+	assert.Equal(t, 2, closed)
+}
+
+func Test_FromChannel(t *testing.T) {
+	channel := make(chan int)
+	go func() {
+		defer close(channel)
+		for i := 1; i <= 5; i++ {
+			channel <- i
+		}
+	}()
+
+	s := stream.FromChannel(channel).Filter(function.NewPredicate(isEven))
+
+	assert.Equal(t, []int{2, 4}, s.ToSlice())
+}
+
+func Test_Range(t *testing.T) {
+	type Case struct {
+		name             string
+		start, end, step int
+		expected         []int
+	}
+
+	cases := []Case{
+		{"ascending", 0, 5, 1, []int{0, 1, 2, 3, 4}},
+		{"ascending with step", 0, 10, 3, []int{0, 3, 6, 9}},
+		{"descending", 5, 0, -2, []int{5, 3, 1}},
+		{"empty ascending", 5, 5, 1, []int{}},
+		{"wrong direction", 0, 5, -1, []int{}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, stream.Range(c.start, c.end, c.step).ToSlice())
+		})
+	}
+}
+
+func Test_Range_Overflow(t *testing.T) {
+	assert.Equal(t, []int8{100, 120}, stream.Range[int8](100, 127, 20).ToSlice())
+	assert.Equal(t, []uint8{250, 253}, stream.Range[uint8](250, 255, 3).ToSlice())
+}
+
+func Test_Range_ZeroStep(t *testing.T) {
+	assert.Panics(t, func() {
+		stream.Range(0, 10, 0)
+	})
+}
+
+func Test_FromSeq(t *testing.T) {
+	s := stream.FromSeq(slices.Values([]int{1, 2, 3}))
+
+	assert.Equal(t, []int{1, 2}, s.Limit(2).ToSlice())
+}
+
+func Test_FromMap(t *testing.T) {
+	s := stream.FromMap(map[string]int{"a": 1, "b": 2})
+
+	entries := s.ToSlice()
+	slices.SortFunc(entries, func(a, b pair.EntryPair[string, int]) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	assert.Equal(t, []pair.EntryPair[string, int]{{Key: "a", Value: 1}, {Key: "b", Value: 2}}, entries)
+}
@@ -0,0 +1,107 @@
+package stream
+
+import (
+	"math"
+
+	"github.com/avila-r/ego/constraint"
+)
+
+// SummaryStatistics accumulates count, sum, min, max and the running mean and
+// variance of numeric values. The zero value is ready to use.
+//
+// Mean and variance are tracked with Welford's online algorithm, so they stay
+// accurate for long streams and large values.
+type SummaryStatistics[N constraint.Number] struct {
+	count int64
+	sum   N
+	min   N
+	max   N
+	mean  float64
+	m2    float64
+}
+
+// Accept records a value
+func (s *SummaryStatistics[N]) Accept(value N) {
+	if s.count == 0 || value < s.min {
+		s.min = value
+	}
+	if s.count == 0 || value > s.max {
+		s.max = value
+	}
+	s.count++
+	s.sum += value
+
+	delta := float64(value) - s.mean
+	s.mean += delta / float64(s.count)
+	s.m2 += delta * (float64(value) - s.mean)
+}
+
+// Combine merges the values recorded by other into s
+func (s *SummaryStatistics[N]) Combine(other *SummaryStatistics[N]) {
+	if other.count == 0 {
+		return
+	}
+	if s.count == 0 {
+		*s = *other
+		return
+	}
+	count := s.count + other.count
+	delta := other.mean - s.mean
+	s.m2 += other.m2 + delta*delta*float64(s.count)*float64(other.count)/float64(count)
+	s.mean += delta * float64(other.count) / float64(count)
+
+	s.count = count
+	s.sum += other.sum
+	s.min = min(s.min, other.min)
+	s.max = max(s.max, other.max)
+}
+
+// Count returns the number of recorded values
+func (s *SummaryStatistics[N]) Count() int64 {
+	return s.count
+}
+
+// Sum returns the sum of the recorded values
+func (s *SummaryStatistics[N]) Sum() N {
+	return s.sum
+}
+
+// Min returns the smallest recorded value, or zero if nothing was recorded
+func (s *SummaryStatistics[N]) Min() N {
+	return s.min
+}
+
+// Max returns the largest recorded value, or zero if nothing was recorded
+func (s *SummaryStatistics[N]) Max() N {
+	return s.max
+}
+
+// Average returns the arithmetic mean of the recorded values, or zero if
+// nothing was recorded
+func (s *SummaryStatistics[N]) Average() float64 {
+	return s.mean
+}
+
+// Variance returns the population variance of the recorded values, or zero
+// if nothing was recorded
+func (s *SummaryStatistics[N]) Variance() float64 {
+	if s.count == 0 {
+		return 0
+	}
+	return s.m2 / float64(s.count)
+}
+
+// SampleVariance returns the sample variance of the recorded values, or zero
+// if fewer than two values were recorded
+func (s *SummaryStatistics[N]) SampleVariance() float64 {
+	if s.count < 2 {
+		return 0
+	}
+	return s.m2 / float64(s.count-1)
+}
+
+// StandardDeviation returns the population standard deviation of the
+// recorded values
+func (s *SummaryStatistics[N]) StandardDeviation() float64 {
+	return math.Sqrt(s.Variance())
+}
@@ -1,201 +1,339 @@
 package stream
 
 import (
+	"iter"
+	"slices"
+
 	"github.com/avila-r/ego/function"
 	"github.com/avila-r/ego/optional"
-	"sort"
 )
 
-type Stream[T comparable] struct {
-	elements []T
+// Stream is a lazy pipeline over a sequence of elements. Intermediate
+// operations only record a new stage; nothing is evaluated until a terminal
+// operation pulls elements through the pipeline one at a time.
+type Stream[T any] struct {
+	seq iter.Seq[T]
+	parallelism
+	resources *resources
 }
 
-func Of[T comparable](elements ...T) Stream[T] {
-	return Stream[T]{elements: elements}
+func Of[T any](elements ...T) Stream[T] {
+	return Stream[T]{seq: slices.Values(elements)}
 }
 
-func From[T comparable](collectable Collectable[T]) Stream[T] {
-	return Stream[T]{elements: collectable.Elements()}
+// From creates a stream over a collectable. Its elements are read when the
+// terminal operation runs, not when the stream is created.
+func From[T any](collectable Collectable[T]) Stream[T] {
+	return Stream[T]{seq: func(yield func(T) bool) {
+		for _, element := range collectable.Elements() {
+			if !yield(element) {
+				return
+			}
+		}
+	}}
 }
 
-func Empty[T comparable]() Stream[T] {
-	return Stream[T]{elements: []T{}}
+func Empty[T any]() Stream[T] {
+	return Stream[T]{seq: func(func(T) bool) {}}
 }
 
-func OfNullable[T comparable](value *T) Stream[T] {
+func OfNullable[T any](value *T) Stream[T] {
 	if value == nil {
 		return Empty[T]()
 	}
 	return Of(*value)
 }
 
-func (s Stream[T]) Filter(predicate function.Predicate[T]) Stream[T] {
-	newElements := make([]T, 0)
-	for _, element := range s.elements {
-		if predicate.Test(element) {
-			newElements = append(newElements, element)
-		}
-	}
-	return Stream[T]{elements: newElements}
+// pipe records a new stage that keeps the stream's parallel settings
+func (s Stream[T]) pipe(seq iter.Seq[T]) Stream[T] {
+	s.seq = seq
+	return s
 }
 
-func Map[T comparable, R comparable](s Stream[T], mapper function.Function[T, R]) Stream[R] {
-	newElements := make([]R, 0, len(s.elements))
-	for _, element := range s.elements {
-		mappedElement := mapper.Apply(element)
-		newElements = append(newElements, mappedElement)
-	}
-	return Stream[R]{elements: newElements}
+// derive records a new stage with a different element type that keeps the
+// stream's parallel settings and resources
+func derive[T, R any](s Stream[T], seq iter.Seq[R]) Stream[R] {
+	return Stream[R]{seq: seq, parallelism: s.parallelism, resources: s.resources}
 }
 
-func FlatMap[T comparable, R comparable](s Stream[T], mapper function.Function[T, Stream[R]]) Stream[R] {
-	newElements := make([]R, 0)
-	for _, element := range s.elements {
-		mappedStream := mapper.Apply(element)
-		newElements = append(newElements, mappedStream.elements...)
+// All returns the pipeline as a sequence that can be used with range.
+// Ranging over it runs every recorded stage.
+func (s Stream[T]) All() iter.Seq[T] {
+	if s.seq == nil {
+		return Empty[T]().seq
 	}
-	return Stream[R]{elements: newElements}
+	return s.seq
 }
 
-func MapMulti[T comparable, R comparable](s Stream[T], mapper function.BiConsumer[T, function.Consumer[R]]) Stream[R] {
-	return FlatMap(s, function.NewFunction(func(t T) Stream[R] {
-		newElements := make([]R, 0)
-		consumer := function.NewConsumer[R](func(r R) {
-			newElements = append(newElements, r)
-		})
-		mapper.Accept(t, consumer)
-		return Stream[R]{elements: newElements}
-	}))
-}
-
-func (s Stream[T]) Distinct() Stream[T] {
-	seen := make(map[T]bool)
-	newElements := make([]T, 0)
-	for _, element := range s.elements {
-		if _, found := seen[element]; !found {
-			seen[element] = true
-			newElements = append(newElements, element)
-		}
+func (s Stream[T]) Filter(predicate function.Predicate[T]) Stream[T] {
+	if s.parallelism.enabled() {
+		return s.pipe(parallelSeq(s.All(), s.parallelism, func(elements []T) []T {
+			filtered := make([]T, 0, len(elements))
+			for _, element := range elements {
+				if predicate.Test(element) {
+					filtered = append(filtered, element)
+				}
+			}
+			return filtered
+		}))
 	}
-	return Stream[T]{elements: newElements}
-}
-
-func (s Stream[T]) Sort() Stream[T] {
-	compare := function.DefaultComparator[T]{}
 
-	for i := 0; i < len(s.elements)-1; i++ {
-		for j := i + 1; j < len(s.elements); j++ {
-			if compare.Compare(s.elements[i], s.elements[j]) > 0 {
-				s.elements[i], s.elements[j] = s.elements[j], s.elements[i]
+	return s.pipe(func(yield func(T) bool) {
+		for element := range s.All() {
+			if predicate.Test(element) && !yield(element) {
+				return
 			}
 		}
+	})
+}
+
+func Map[T, R any](s Stream[T], mapper function.Function[T, R]) Stream[R] {
+	if s.parallelism.enabled() {
+		return derive(s, parallelSeq(s.All(), s.parallelism, func(elements []T) []R {
+			mapped := make([]R, len(elements))
+			for i, element := range elements {
+				mapped[i] = mapper.Apply(element)
+			}
+			return mapped
+		}))
 	}
 
-	return Stream[T]{elements: s.elements}
+	return derive(s, func(yield func(R) bool) {
+		for element := range s.All() {
+			if !yield(mapper.Apply(element)) {
+				return
+			}
+		}
+	})
 }
 
-func (s Stream[T]) Sorted(comparator function.Comparator[T]) Stream[T] {
-	sort.Slice(s.elements, func(i, j int) bool {
-		return comparator.Compare(s.elements[i], s.elements[j]) < 0
+// FlatMap replaces each element with the elements of the stream returned by
+// mapper. Each mapped stream is closed once it has been consumed, and its
+// source error is reported by Err.
+func FlatMap[T, R any](s Stream[T], mapper function.Function[T, Stream[R]]) Stream[R] {
+	s = s.withResources()
+	return derive(s, func(yield func(R) bool) {
+		for element := range s.All() {
+			if !flatten(s.resources, mapper.Apply(element), yield) {
+				return
+			}
+		}
 	})
+}
+
+// flatten yields the elements of inner, closes it and records its error in
+// outer. It reports whether the consumer wants more elements.
+func flatten[R any](outer *resources, inner Stream[R], yield func(R) bool) bool {
+	more := true
+	for element := range inner.All() {
+		if !yield(element) {
+			more = false
+			break
+		}
+	}
+	if err := inner.Err(); err != nil {
+		outer.fail(err)
+	}
+	if err := inner.Close(); err != nil {
+		outer.fail(err)
+	}
+	return more
+}
 
-	return Stream[T]{elements: s.elements}
+func MapMulti[T, R any](s Stream[T], mapper function.BiConsumer[T, function.Consumer[R]]) Stream[R] {
+	return derive(s, func(yield func(R) bool) {
+		stopped := false
+		consumer := function.NewConsumer(func(r R) {
+			if !stopped && !yield(r) {
+				stopped = true
+			}
+		})
+		for element := range s.All() {
+			mapper.Accept(element, consumer)
+			if stopped {
+				return
+			}
+		}
+	})
 }
 
 func (s Stream[T]) Peek(consumer function.Consumer[T]) Stream[T] {
-	for _, element := range s.elements {
-		consumer.Accept(element)
-	}
-	return s
+	return s.pipe(func(yield func(T) bool) {
+		for element := range s.All() {
+			consumer.Accept(element)
+			if !yield(element) {
+				return
+			}
+		}
+	})
 }
 
+// Limit truncates the stream to at most number elements. Upstream stages
+// stop being pulled as soon as the limit is reached.
 func (s Stream[T]) Limit(number int) Stream[T] {
-	if number >= len(s.elements) {
-		return s
-	}
-	return Stream[T]{elements: s.elements[:number]}
+	return s.pipe(func(yield func(T) bool) {
+		if number <= 0 {
+			return
+		}
+		taken := 0
+		for element := range s.All() {
+			if !yield(element) {
+				return
+			}
+			taken++
+			if taken >= number {
+				return
+			}
+		}
+	})
 }
 
 func (s Stream[T]) Skip(quantity int) Stream[T] {
-	if quantity >= len(s.elements) {
-		return Stream[T]{elements: []T{}}
-	}
-	return Stream[T]{elements: s.elements[quantity:]}
+	return s.pipe(func(yield func(T) bool) {
+		skipped := 0
+		for element := range s.All() {
+			if skipped < quantity {
+				skipped++
+				continue
+			}
+			if !yield(element) {
+				return
+			}
+		}
+	})
 }
 
+// TakeWhile emits elements until the predicate first fails, then stops
+// pulling from upstream.
 func (s Stream[T]) TakeWhile(predicate function.Predicate[T]) Stream[T] {
-	newElements := make([]T, 0)
-	for _, element := range s.elements {
-		if !predicate.Test(element) {
-			break
+	return s.pipe(func(yield func(T) bool) {
+		for element := range s.All() {
+			if !predicate.Test(element) || !yield(element) {
+				return
+			}
 		}
-		newElements = append(newElements, element)
-	}
-	return Stream[T]{elements: newElements}
+	})
 }
 
 func (s Stream[T]) DropWhile(predicate function.Predicate[T]) Stream[T] {
-	newElements := make([]T, 0)
-	dropping := true
-	for _, element := range s.elements {
-		if dropping && predicate.Test(element) {
-			continue
+	return s.pipe(func(yield func(T) bool) {
+		dropping := true
+		for element := range s.All() {
+			if dropping && predicate.Test(element) {
+				continue
+			}
+			dropping = false
+			if !yield(element) {
+				return
+			}
 		}
-		dropping = false
-		newElements = append(newElements, element)
-	}
-	return Stream[T]{elements: newElements}
+	})
 }
 
 func (s Stream[T]) ForEach(consumer function.Consumer[T]) {
-	for _, element := range s.elements {
+	for element := range s.All() {
 		consumer.Accept(element)
 	}
 }
 
+// ForEachOrdered feeds every element to consumer in encounter order, even
+// when upstream stages run in parallel
 func (s Stream[T]) ForEachOrdered(consumer function.Consumer[T]) {
-	s.Sort().ForEach(consumer)
+	for element := range s.All() {
+		consumer.Accept(element)
+	}
 }
 
-// ToSlice returns a copy of the stream's elements as a slice
+// ToSlice runs the pipeline and returns its elements in a new slice
 func (s Stream[T]) ToSlice() []T {
-	target := make([]T, len(s.elements))
-	copy(target, s.elements)
+	target := make([]T, 0)
+	for element := range s.All() {
+		target = append(target, element)
+	}
 	return target
 }
+
+// ReduceWithIdentity folds the elements starting from identity. On a parallel
+// stream every chunk is folded from identity and the partial results are
+// merged with accumulator, so identity must be a true identity for it.
 func (s Stream[T]) ReduceWithIdentity(identity T, accumulator function.BinaryOperator[T]) T {
+	if s.parallelism.enabled() {
+		result, found := parallelFold(s.All(), s.parallelism, func(elements []T) T {
+			partial := identity
+			for _, element := range elements {
+				partial = accumulator.Apply(partial, element)
+			}
+			return partial
+		}, accumulator.Apply)
+		if !found {
+			return identity
+		}
+		return result
+	}
+
 	result := identity
-	for _, element := range s.elements {
+	for element := range s.All() {
 		result = accumulator.Apply(result, element)
 	}
 	return result
 }
 
 func (s Stream[T]) Reduce(accumulator function.BinaryOperator[T]) optional.Optional[T] {
-	if len(s.elements) == 0 {
-		var zero optional.Optional[T]
-		return zero
+	if s.parallelism.enabled() {
+		result, found := parallelFold(s.All(), s.parallelism, func(elements []T) T {
+			partial := elements[0]
+			for _, element := range elements[1:] {
+				partial = accumulator.Apply(partial, element)
+			}
+			return partial
+		}, accumulator.Apply)
+		if !found {
+			return optional.Empty[T]()
+		}
+		return optional.Of(result)
 	}
-	result := s.elements[0]
-	for _, element := range s.elements[1:] {
+
+	var result T
+	found := false
+	for element := range s.All() {
+		if !found {
+			result, found = element, true
+			continue
+		}
 		result = accumulator.Apply(result, element)
 	}
+	if !found {
+		return optional.Empty[T]()
+	}
 	return optional.Of(result)
 }
 
-func Reduce[U comparable](s Stream[U], identity U, accumulator function.BinaryOperator[U]) U {
-	result := identity
-	for _, element := range s.elements {
-		result = accumulator.Apply(result, element)
-	}
-	return result
+func Reduce[U any](s Stream[U], identity U, accumulator function.BinaryOperator[U]) U {
+	return s.ReduceWithIdentity(identity, accumulator)
 }
 
-func Collect[T, A, R any](stream Stream[any], collector Collector[T, A, R]) R {
-	acc := collector.Supplier().Get()
-
+// Collect runs the pipeline into collector. On a parallel stream every chunk
+// is accumulated into its own container and the containers are merged with
+// the collector's combiner; collectors without a combiner run sequentially.
+func Collect[T, A, R any](stream Stream[T], collector Collector[T, A, R]) R {
 	accumulator := collector.Accumulator()
-	for _, e := range stream.elements {
+
+	if combiner := collector.Combiner(); combiner != nil && stream.parallelism.enabled() {
+		acc, found := parallelFold(stream.All(), stream.parallelism, func(elements []T) A {
+			partial := collector.Supplier().Get()
+			for _, element := range elements {
+				accumulator.Accept(partial, element)
+			}
+			return partial
+		}, combiner.Apply)
+		if !found {
+			acc = collector.Supplier().Get()
+		}
+		return collector.Finisher().Apply(acc)
+	}
+
+	acc := collector.Supplier().Get()
+	for e := range stream.All() {
 		accumulator.Accept(acc, e)
 	}
 
@@ -211,26 +349,36 @@ func (s Stream[T]) Max(comparator function.Comparator[T]) optional.Optional[T] {
 }
 
 func (s Stream[T]) findMinimumOrMaximum(isMin bool, comparator function.Comparator[T]) optional.Optional[T] {
-	if len(s.elements) == 0 {
-		var empty optional.Optional[T]
-		return empty
-	}
-	m := s.elements[0]
-	for _, e := range s.elements[1:] {
+	var m T
+	found := false
+	for e := range s.All() {
+		if !found {
+			m, found = e, true
+			continue
+		}
 		compareResult := comparator.Compare(e, m)
 		if (isMin && compareResult < 0) || (!isMin && compareResult > 0) {
 			m = e
 		}
 	}
+	if !found {
+		return optional.Empty[T]()
+	}
 	return optional.Of(m)
 }
 
 func (s Stream[T]) Count() int64 {
-	return int64(len(s.elements))
+	var count int64
+	for range s.All() {
+		count++
+	}
+	return count
 }
 
+// AnyMatch reports whether any element matches the predicate.
+// It stops pulling from upstream at the first match.
 func (s Stream[T]) AnyMatch(predicate function.Predicate[T]) bool {
-	for _, e := range s.elements {
+	for e := range s.All() {
 		if predicate.Test(e) {
 			return true
 		}
@@ -238,8 +386,10 @@ func (s Stream[T]) AnyMatch(predicate function.Predicate[T]) bool {
 	return false
 }
 
+// AllMatch reports whether every element matches the predicate.
+// It stops pulling from upstream at the first mismatch.
 func (s Stream[T]) AllMatch(predicate function.Predicate[T]) bool {
-	for _, e := range s.elements {
+	for e := range s.All() {
 		if !predicate.Test(e) {
 			return false
 		}
@@ -247,62 +397,81 @@ func (s Stream[T]) AllMatch(predicate function.Predicate[T]) bool {
 	return true
 }
 
+// NoneMatch reports whether no element matches the predicate.
+// It stops pulling from upstream at the first match.
 func (s Stream[T]) NoneMatch(predicate function.Predicate[T]) bool {
-	for _, e := range s.elements {
-		if predicate.Test(e) {
-			return false
-		}
-	}
-	return true
+	return !s.AnyMatch(predicate)
 }
 
+// FindFirst returns the first element of the stream, pulling only that
+// element through the pipeline.
 func (s Stream[T]) FindFirst() optional.Optional[T] {
-	if len(s.elements) == 0 {
-		var empty optional.Optional[T]
-		return empty
+	for e := range s.All() {
+		return optional.Of(e)
 	}
-	return optional.Of(s.elements[0])
+	return optional.Empty[T]()
 }
 
 func (s Stream[T]) FindAny() optional.Optional[T] {
 	return s.FindFirst()
 }
 
-func Concat[T comparable](a, b Stream[T]) Stream[T] {
-	combined := make([]T, 0, len(a.elements)+len(b.elements))
-	combined = append(combined, a.elements...)
-	combined = append(combined, b.elements...)
-	return Stream[T]{elements: combined}
+// Concat appends b to a. The result keeps a's parallel settings, reports
+// source errors from both streams and closes both when it is closed.
+func Concat[T any](a, b Stream[T]) Stream[T] {
+	concatenated := a
+	concatenated.resources = &resources{parents: []*resources{a.resources, b.resources}}
+	return concatenated.pipe(func(yield func(T) bool) {
+		for element := range a.All() {
+			if !yield(element) {
+				return
+			}
+		}
+		for element := range b.All() {
+			if !yield(element) {
+				return
+			}
+		}
+	})
 }
 
-func Generate[T comparable](supplier function.Supplier[T], limit int) Stream[T] {
-	elements := make([]T, 0, limit)
-	for i := 0; i < limit; i++ {
-		elements = append(elements, supplier.Get())
-	}
-	return Stream[T]{elements: elements}
+// Generate creates an infinite stream of values produced by supplier.
+// Bound it with a short-circuiting operation such as Limit or TakeWhile.
+func Generate[T any](supplier function.Supplier[T]) Stream[T] {
+	return Stream[T]{seq: func(yield func(T) bool) {
+		for yield(supplier.Get()) {
+		}
+	}}
 }
 
-func Iterate[T comparable](seed T, f function.UnaryOperator[T], limit int) Stream[T] {
-	elements := make([]T, 0, limit)
-	current := seed
-	for i := 0; i < limit; i++ {
-		elements = append(elements, current)
-		current = f.Apply(current)
-	}
-	return Stream[T]{elements: elements}
+// Iterate creates an infinite stream of seed, f(seed), f(f(seed)), ...
+// Bound it with a short-circuiting operation such as Limit or TakeWhile.
+func Iterate[T any](seed T, f function.UnaryOperator[T]) Stream[T] {
+	return Stream[T]{seq: func(yield func(T) bool) {
+		for current := seed; yield(current); current = f.Apply(current) {
+		}
+	}}
 }
 
-func IterateWhile[T comparable](
+func IterateWhile[T any](
 	seed T,
 	hasNext function.Predicate[T],
 	next function.UnaryOperator[T],
 ) Stream[T] {
-	elements := make([]T, 0)
-	current := seed
-	for hasNext.Test(current) {
-		elements = append(elements, current)
-		current = next.Apply(current)
+	return Stream[T]{seq: func(yield func(T) bool) {
+		for current := seed; hasNext.Test(current); current = next.Apply(current) {
+			if !yield(current) {
+				return
+			}
+		}
+	}}
+}
+
+// emit yields each element in order until the consumer stops
+func emit[T any](elements []T, yield func(T) bool) {
+	for _, element := range elements {
+		if !yield(element) {
+			return
+		}
 	}
-	return Stream[T]{elements: elements}
 }
@@ -0,0 +1,280 @@
+package stream_test
+
+import (
+	"testing"
+
+	"github.com/avila-r/ego/function"
+	"github.com/avila-r/ego/stream"
+	"github.com/stretchr/testify/assert"
+)
+
+func isEven(n int) bool { return n%2 == 0 }
+
+func Test_Of(t *testing.T) {
+	type Case struct {
+		name     string
+		elements []int
+		expected []int
+	}
+
+	cases := []Case{
+		{"empty stream", []int{}, []int{}},
+		{"single element", []int{1}, []int{1}},
+		{"multiple elements", []int{1, 2, 3}, []int{1, 2, 3}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, stream.Of(c.elements...).ToSlice())
+		})
+	}
+}
+
+func Test_IsLazy(t *testing.T) {
+	calls := 0
+	s := stream.Of(1, 2, 3, 4).Filter(function.NewPredicate(func(n int) bool {
+		calls++
+		return isEven(n)
+	}))
+
+	// Recording stages does not evaluate them
+	assert.Equal(t, 0, calls)
+
+	assert.Equal(t, []int{2, 4}, s.ToSlice())
+	assert.Equal(t, 4, calls)
+}
+
+func Test_ElementsFlowOneAtATime(t *testing.T) {
+	var trace []string
+	s := stream.Of(1, 2).
+		Peek(function.NewConsumer(func(n int) { trace = append(trace, "peek") })).
+		Filter(function.NewPredicate(func(n int) bool {
+			trace = append(trace, "filter")
+			return true
+		}))
+
+	s.ForEach(function.NewConsumer(func(n int) { trace = append(trace, "each") }))
+
+	assert.Equal(t, []string{"peek", "filter", "each", "peek", "filter", "each"}, trace)
+}
+
+func Test_Map(t *testing.T) {
+	s := stream.Map(stream.Of(1, 2, 3), function.NewFunction(func(n int) string {
+		return string(rune('a' + n - 1))
+	}))
+
+	assert.Equal(t, []string{"a", "b", "c"}, s.ToSlice())
+}
+
+func Test_FlatMap(t *testing.T) {
+	s := stream.FlatMap(stream.Of(1, 2, 3), function.NewFunction(func(n int) stream.Stream[int] {
+		return stream.Of(n, n*10)
+	}))
+
+	assert.Equal(t, []int{1, 10, 2, 20, 3, 30}, s.ToSlice())
+	assert.Equal(t, []int{1, 10, 2}, s.Limit(3).ToSlice())
+}
+
+func Test_MapMulti(t *testing.T) {
+	s := stream.MapMulti(stream.Of(1, 2, 3), function.NewBiConsumer(func(n int, c function.Consumer[int]) {
+		for i := 0; i < n; i++ {
+			c.Accept(n)
+		}
+	}))
+
+	assert.Equal(t, []int{1, 2, 2, 3, 3, 3}, s.ToSlice())
+	assert.Equal(t, []int{1, 2}, s.Limit(2).ToSlice())
+}
+
+func Test_Distinct(t *testing.T) {
+	assert.Equal(t, []int{1, 2, 3}, stream.Distinct(stream.Of(1, 2, 1, 3, 2)).ToSlice())
+}
+
+func Test_Sorted_DoesNotMutateSource(t *testing.T) {
+	source := []int{3, 1, 2}
+	comparator := function.NewComparator(func(a, b int) int { return a - b })
+
+	sorted := stream.Of(source...).Sorted(comparator).ToSlice()
+
+	assert.Equal(t, []int{1, 2, 3}, sorted)
+	assert.Equal(t, []int{3, 1, 2}, source)
+}
+
+func Test_Limit(t *testing.T) {
+	type Case struct {
+		name     string
+		limit    int
+		expected []int
+	}
+
+	cases := []Case{
+		{"limit zero", 0, []int{}},
+		{"limit negative", -1, []int{}},
+		{"limit within size", 2, []int{1, 2}},
+		{"limit beyond size", 10, []int{1, 2, 3}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, stream.Of(1, 2, 3).Limit(c.limit).ToSlice())
+		})
+	}
+}
+
+func Test_Limit_StopsPullingUpstream(t *testing.T) {
+	pulled := 0
+	s := stream.Of(1, 2, 3, 4, 5).
+		Peek(function.NewConsumer(func(int) { pulled++ })).
+		Limit(2)
+
+	assert.Equal(t, []int{1, 2}, s.ToSlice())
+	assert.Equal(t, 2, pulled)
+}
+
+func Test_Skip(t *testing.T) {
+	assert.Equal(t, []int{3, 4}, stream.Of(1, 2, 3, 4).Skip(2).ToSlice())
+	assert.Equal(t, []int{}, stream.Of(1, 2).Skip(5).ToSlice())
+}
+
+func Test_TakeWhile(t *testing.T) {
+	pulled := 0
+	s := stream.Of(1, 2, 3, 4, 1).
+		Peek(function.NewConsumer(func(int) { pulled++ })).
+		TakeWhile(function.NewPredicate(func(n int) bool { return n < 3 }))
+
+	assert.Equal(t, []int{1, 2}, s.ToSlice())
+	assert.Equal(t, 3, pulled)
+}
+
+func Test_DropWhile(t *testing.T) {
+	s := stream.Of(1, 2, 3, 4, 1).DropWhile(function.NewPredicate(func(n int) bool { return n < 3 }))
+
+	assert.Equal(t, []int{3, 4, 1}, s.ToSlice())
+}
+
+func Test_FindFirst(t *testing.T) {
+	pulled := 0
+	s := stream.Of(1, 2, 3, 4).
+		Peek(function.NewConsumer(func(int) { pulled++ })).
+		Filter(function.NewPredicate(isEven))
+
+	first := s.FindFirst()
+	value, ok := first.Get()
+	assert.True(t, ok)
+	assert.Equal(t, 2, value)
+	assert.Equal(t, 2, pulled)
+
+	empty := stream.Empty[int]().FindFirst()
+	assert.True(t, empty.IsEmpty())
+}
+
+func Test_Matching(t *testing.T) {
+	type Case struct {
+		name     string
+		elements []int
+		any      bool
+		all      bool
+		none     bool
+	}
+
+	cases := []Case{
+		{"empty stream", []int{}, false, true, true},
+		{"all even", []int{2, 4}, true, true, false},
+		{"some even", []int{1, 2}, true, false, false},
+		{"no even", []int{1, 3}, false, false, true},
+	}
+
+	predicate := function.NewPredicate(isEven)
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			s := stream.Of(c.elements...)
+			assert.Equal(t, c.any, s.AnyMatch(predicate))
+			assert.Equal(t, c.all, s.AllMatch(predicate))
+			assert.Equal(t, c.none, s.NoneMatch(predicate))
+		})
+	}
+}
+
+func Test_AnyMatch_ShortCircuits(t *testing.T) {
+	pulled := 0
+	s := stream.Of(1, 2, 3, 4).Peek(function.NewConsumer(func(int) { pulled++ }))
+
+	assert.True(t, s.AnyMatch(function.NewPredicate(isEven)))
+	assert.Equal(t, 2, pulled)
+}
+
+func Test_Reduce(t *testing.T) {
+	sum := function.NewBinaryOperator(func(a, b int) int { return a + b })
+
+	result := stream.Of(1, 2, 3).Reduce(sum)
+	value, ok := result.Get()
+	assert.True(t, ok)
+	assert.Equal(t, 6, value)
+
+	empty := stream.Empty[int]().Reduce(sum)
+	assert.True(t, empty.IsEmpty())
+
+	assert.Equal(t, 16, stream.Reduce(stream.Of(1, 2, 3), 10, sum))
+}
+
+func Test_MinMax(t *testing.T) {
+	comparator := function.NewComparator(func(a, b int) int { return a - b })
+	s := stream.Of(3, 1, 4, 1, 5)
+
+	min := s.Min(comparator)
+	max := s.Max(comparator)
+	assert.Equal(t, 1, min.Join())
+	assert.Equal(t, 5, max.Join())
+
+	empty := stream.Empty[int]().Min(comparator)
+	assert.True(t, empty.IsEmpty())
+}
+
+func Test_Count(t *testing.T) {
+	assert.Equal(t, int64(2), stream.Of(1, 2, 3, 4).Filter(function.NewPredicate(isEven)).Count())
+}
+
+func Test_Concat(t *testing.T) {
+	s := stream.Concat(stream.Of(1, 2), stream.Of(3))
+
+	assert.Equal(t, []int{1, 2, 3}, s.ToSlice())
+	assert.Equal(t, []int{1}, s.Limit(1).ToSlice())
+}
+
+func Test_Generate(t *testing.T) {
+	n := 0
+	s := stream.Generate(function.NewSupplier(func() int {
+		n++
+		return n
+	}))
+
+	assert.Equal(t, []int{1, 2, 3}, s.Limit(3).ToSlice())
+}
+
+func Test_Iterate(t *testing.T) {
+	s := stream.Iterate(1, function.NewUnaryOperator(func(n int) int { return n * 2 }))
+
+	assert.Equal(t, []int{1, 2, 4, 8}, s.Limit(4).ToSlice())
+	assert.Equal(t, []int{1, 2, 4}, s.TakeWhile(function.NewPredicate(func(n int) bool { return n < 5 })).ToSlice())
+}
+
+func Test_IterateWhile(t *testing.T) {
+	s := stream.IterateWhile(1,
+		function.NewPredicate(func(n int) bool { return n < 10 }),
+		function.NewUnaryOperator(func(n int) int { return n * 3 }),
+	)
+
+	assert.Equal(t, []int{1, 3, 9}, s.ToSlice())
+}
+
+func Test_All(t *testing.T) {
+	var result []int
+	for v := range stream.Iterate(1, function.NewUnaryOperator(func(n int) int { return n + 1 })).All() {
+		if v > 3 {
+			break
+		}
+		result = append(result, v)
+	}
+
+	assert.Equal(t, []int{1, 2, 3}, result)
+}
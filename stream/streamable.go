@@ -1,6 +1,6 @@
 package stream
 
-type Streamable[T comparable] interface {
+type Streamable[T any] interface {
 	Stream() Stream[T]
 }
 
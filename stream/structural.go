@@ -0,0 +1,172 @@
+package stream
+
+import (
+	"iter"
+
+	"github.com/avila-r/ego/function"
+	"github.com/avila-r/ego/pair"
+)
+
+// Chunk groups consecutive elements into slices of size elements. The last
+// chunk holds the remaining elements and may be shorter. Chunk panics with
+// ErrNonPositiveSize if size is not positive.
+func Chunk[T any](s Stream[T], size int) Stream[[]T] {
+	if size <= 0 {
+		ErrNonPositiveSize.Panic()
+	}
+	return derive(s, func(yield func([]T) bool) {
+		chunk := make([]T, 0, size)
+		for element := range s.All() {
+			chunk = append(chunk, element)
+			if len(chunk) < size {
+				continue
+			}
+			if !yield(chunk) {
+				return
+			}
+			chunk = make([]T, 0, size)
+		}
+		if len(chunk) > 0 {
+			yield(chunk)
+		}
+	})
+}
+
+// Window emits sliding windows of exactly size elements, starting a new
+// window every step elements. Trailing elements that cannot fill a window
+// are dropped. Each window is a new slice. Window panics with
+// ErrNonPositiveSize if size or step is not positive.
+func Window[T any](s Stream[T], size, step int) Stream[[]T] {
+	if size <= 0 || step <= 0 {
+		ErrNonPositiveSize.Panic()
+	}
+	return derive(s, func(yield func([]T) bool) {
+		window := make([]T, 0, size)
+		skip := 0
+		for element := range s.All() {
+			if skip > 0 {
+				skip--
+				continue
+			}
+			window = append(window, element)
+			if len(window) < size {
+				continue
+			}
+			if !yield(append([]T(nil), window...)) {
+				return
+			}
+			if step >= size {
+				window = window[:0]
+				skip = step - size
+				continue
+			}
+			window = window[:copy(window, window[step:])]
+		}
+	})
+}
+
+// Zip pairs the elements of a and b by position. The result ends when
+// either stream ends.
+func Zip[A, B any](a Stream[A], b Stream[B]) Stream[pair.Pair[A, B]] {
+	return ZipWith(a, b, function.NewBiFunction(pair.Of[A, B]))
+}
+
+// ZipWith combines the elements of a and b by position. The result ends
+// when either stream ends. It keeps a's parallel settings, reports source
+// errors from both streams and closes both when it is closed.
+func ZipWith[A, B, R any](a Stream[A], b Stream[B], combiner function.BiFunction[A, B, R]) Stream[R] {
+	zipped := derive(a, func(yield func(R) bool) {
+		next, stop := iter.Pull(b.All())
+		defer stop()
+		for left := range a.All() {
+			right, ok := next()
+			if !ok || !yield(combiner.Apply(left, right)) {
+				return
+			}
+		}
+	})
+	zipped.resources = &resources{parents: []*resources{a.resources, b.resources}}
+	return zipped
+}
+
+// Enumerate pairs each element with its zero-based position
+func Enumerate[T any](s Stream[T]) Stream[pair.Pair[int, T]] {
+	return derive(s, func(yield func(pair.Pair[int, T]) bool) {
+		index := 0
+		for element := range s.All() {
+			if !yield(pair.Of(index, element)) {
+				return
+			}
+			index++
+		}
+	})
+}
+
+// Scan emits the running accumulation of the elements, starting from
+// identity. The identity itself is not emitted, so Scan of 1, 2, 3 with
+// identity 0 and addition emits 1, 3, 6.
+func Scan[T, R any](s Stream[T], identity R, accumulator function.BiFunction[R, T, R]) Stream[R] {
+	return derive(s, func(yield func(R) bool) {
+		current := identity
+		for element := range s.All() {
+			current = accumulator.Apply(current, element)
+			if !yield(current) {
+				return
+			}
+		}
+	})
+}
+
+// Interleave takes one element from each stream in turn. Exhausted streams
+// are dropped from the rotation until every stream has ended. The result
+// keeps the first stream's parallel settings, reports source errors from
+// every stream and closes all of them when it is closed.
+func Interleave[T any](streams ...Stream[T]) Stream[T] {
+	if len(streams) == 0 {
+		return Empty[T]()
+	}
+
+	interleaved := streams[0]
+	interleaved.resources = &resources{}
+	for _, s := range streams {
+		interleaved.resources.parents = append(interleaved.resources.parents, s.resources)
+	}
+	return interleaved.pipe(func(yield func(T) bool) {
+		pulls := make([]func() (T, bool), 0, len(streams))
+		for _, s := range streams {
+			next, stop := iter.Pull(s.All())
+			defer stop()
+			pulls = append(pulls, next)
+		}
+		for len(pulls) > 0 {
+			active := pulls[:0]
+			for _, next := range pulls {
+				element, ok := next()
+				if !ok {
+					continue
+				}
+				if !yield(element) {
+					return
+				}
+				active = append(active, next)
+			}
+			pulls = active
+		}
+	})
+}
+
+// Intersperse emits separator between consecutive elements
+func (s Stream[T]) Intersperse(separator T) Stream[T] {
+	return s.pipe(func(yield func(T) bool) {
+		first := true
+		for element := range s.All() {
+			if !first && !yield(separator) {
+				return
+			}
+			first = false
+			if !yield(element) {
+				return
+			}
+		}
+	})
+}
@@ -0,0 +1,166 @@
+package stream_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/avila-r/ego/failure"
+	"github.com/avila-r/ego/function"
+	"github.com/avila-r/ego/pair"
+	"github.com/avila-r/ego/stream"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Chunk(t *testing.T) {
+	type Case struct {
+		name     string
+		elements []int
+		size     int
+		expected [][]int
+	}
+
+	cases := []Case{
+		{"empty stream", []int{}, 2, [][]int{}},
+		{"exact chunks", []int{1, 2, 3, 4}, 2, [][]int{{1, 2}, {3, 4}}},
+		{"partial last chunk", []int{1, 2, 3, 4, 5}, 2, [][]int{{1, 2}, {3, 4}, {5}}},
+		{"size larger than stream", []int{1, 2}, 5, [][]int{{1, 2}}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, stream.Chunk(stream.Of(c.elements...), c.size).ToSlice())
+		})
+	}
+}
+
+func Test_Chunk_InvalidSize(t *testing.T) {
+	assert.Panics(t, func() {
+		stream.Chunk(stream.Of(1), 0)
+	})
+}
+
+func Test_Chunk_Unbounded(t *testing.T) {
+	naturals := stream.Iterate(1, function.NewUnaryOperator(func(n int) int { return n + 1 }))
+
+	assert.Equal(t, [][]int{{1, 2, 3}, {4, 5, 6}}, stream.Chunk(naturals, 3).Limit(2).ToSlice())
+}
+
+func Test_Window(t *testing.T) {
+	type Case struct {
+		name       string
+		elements   []int
+		size, step int
+		expected   [][]int
+	}
+
+	cases := []Case{
+		{"sliding by one", []int{1, 2, 3, 4}, 2, 1, [][]int{{1, 2}, {2, 3}, {3, 4}}},
+		{"sliding by two", []int{1, 2, 3, 4, 5}, 3, 2, [][]int{{1, 2, 3}, {3, 4, 5}}},
+		{"tumbling", []int{1, 2, 3, 4, 5}, 2, 2, [][]int{{1, 2}, {3, 4}}},
+		{"step beyond size", []int{1, 2, 3, 4, 5, 6, 7}, 2, 3, [][]int{{1, 2}, {4, 5}}},
+		{"too short", []int{1, 2}, 3, 1, [][]int{}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, stream.Window(stream.Of(c.elements...), c.size, c.step).ToSlice())
+		})
+	}
+}
+
+func Test_Window_InvalidArguments(t *testing.T) {
+	assert.Panics(t, func() {
+		stream.Window(stream.Of(1), 2, 0)
+	})
+	assert.Panics(t, func() {
+		stream.Window(stream.Of(1), 0, 1)
+	})
+}
+
+func Test_Zip(t *testing.T) {
+	zipped := stream.Zip(stream.Of(1, 2, 3), stream.Of("a", "b"))
+
+	assert.Equal(t, []pair.Pair[int, string]{pair.Of(1, "a"), pair.Of(2, "b")}, zipped.ToSlice())
+}
+
+func Test_ZipWith(t *testing.T) {
+	naturals := stream.Iterate(1, function.NewUnaryOperator(func(n int) int { return n + 1 }))
+	products := stream.ZipWith(stream.Of(10, 20, 30), naturals, function.NewBiFunction(func(a, b int) int {
+		return a * b
+	}))
+
+	assert.Equal(t, []int{10, 40, 90}, products.ToSlice())
+	assert.Equal(t, []int{10}, products.Limit(1).ToSlice())
+}
+
+func Test_Enumerate(t *testing.T) {
+	enumerated := stream.Enumerate(stream.Of("a", "b", "c"))
+
+	expected := []pair.Pair[int, string]{pair.Of(0, "a"), pair.Of(1, "b"), pair.Of(2, "c")}
+	assert.Equal(t, expected, enumerated.ToSlice())
+}
+
+func Test_Scan(t *testing.T) {
+	sums := stream.Scan(stream.Of(1, 2, 3, 4), 0, function.NewBiFunction(func(acc, n int) int {
+		return acc + n
+	}))
+
+	assert.Equal(t, []int{1, 3, 6, 10}, sums.ToSlice())
+	assert.Equal(t, []int{}, stream.Scan(stream.Empty[int](), 0, function.NewBiFunction(func(acc, n int) int {
+		return acc + n
+	})).ToSlice())
+}
+
+func Test_Interleave(t *testing.T) {
+	type Case struct {
+		name     string
+		streams  [][]int
+		expected []int
+	}
+
+	cases := []Case{
+		{"no streams", [][]int{}, []int{}},
+		{"single stream", [][]int{{1, 2}}, []int{1, 2}},
+		{"equal lengths", [][]int{{1, 3}, {2, 4}}, []int{1, 2, 3, 4}},
+		{"uneven lengths", [][]int{{1, 4, 6}, {2}, {3, 5}}, []int{1, 2, 3, 4, 5, 6}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			streams := make([]stream.Stream[int], 0, len(c.streams))
+			for _, elements := range c.streams {
+				streams = append(streams, stream.Of(elements...))
+			}
+			assert.Equal(t, c.expected, stream.Interleave(streams...).ToSlice())
+		})
+	}
+}
+
+func Test_Intersperse(t *testing.T) {
+	assert.Equal(t, []string{"a", ",", "b", ",", "c"}, stream.Of("a", "b", "c").Intersperse(",").ToSlice())
+	assert.Equal(t, []string{"a"}, stream.Of("a").Intersperse(",").ToSlice())
+	assert.Equal(t, []string{}, stream.Empty[string]().Intersperse(",").ToSlice())
+}
+
+func Test_ZipAndInterleave_KeepEveryResource(t *testing.T) {
+	cause := errors.New("disk on fire")
+	closed := 0
+	handler := func() error { closed++; return nil }
+
+	failing := func() stream.Stream[string] {
+		return stream.Lines(&failingReader{data: "x\n", err: cause}).OnClose(handler)
+	}
+
+	zipped := stream.Zip(stream.Of("a", "b").OnClose(handler), failing())
+	assert.Len(t, zipped.ToSlice(), 1)
+	assert.Equal(t, cause, failure.Cast(zipped.Err()).Cause())
+	assert.NoError(t, zipped.Close())
+	assert.Equal(t, 2, closed)
+
+	closed = 0
+	interleaved := stream.Interleave(stream.Of("a").OnClose(handler), stream.Of("b").OnClose(handler), failing())
+	assert.Equal(t, []string{"a", "b", "x"}, interleaved.ToSlice())
+	assert.Equal(t, cause, failure.Cast(interleaved.Err()).Cause())
+	assert.NoError(t, interleaved.Close())
+	assert.Equal(t, 3, closed)
+}